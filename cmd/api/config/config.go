@@ -1,8 +1,11 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"log"
 	"os"
 )
 
@@ -14,10 +17,14 @@ type Config struct {
 }
 
 type ServerConfig struct {
-	Port     string
-	Host     string
-	BindHost string
-	Env      string
+	Port         string
+	Host         string
+	BindHost     string
+	Env          string
+	CursorSecret string
+	AdminToken   string
+	// RunBackgroundJobs activa los procesos periodicos que recorren la coleccion, solo debe estar en una replica
+	RunBackgroundJobs bool
 }
 
 type MongoDBConfig struct {
@@ -30,6 +37,10 @@ type MongoDBConfig struct {
 	Uri        string
 	Database   string
 	Collection string
+
+	PriceHistoryCollection       string
+	SavedSearchesCollection      string
+	SavedSearchMatchesCollection string
 }
 
 type CacheConfig struct {
@@ -51,10 +62,14 @@ type BucketR2Config struct {
 func Load() (*Config, error) {
 	config := &Config{
 		Server: ServerConfig{
-			Port:     getEnv("PORT", "8080"),
-			Host:     getEnv("HOST", "0.0.0.0"),
-			BindHost: getEnv("BIND_HOST", "0.0.0.0"),
-			Env:      getEnv("ENV", "local"),
+			Port:         getEnv("PORT", "8080"),
+			Host:         getEnv("HOST", "0.0.0.0"),
+			BindHost:     getEnv("BIND_HOST", "0.0.0.0"),
+			Env:          getEnv("ENV", "local"),
+			CursorSecret: getEnv("CURSOR_SECRET", ""),
+			AdminToken:   getEnv("ADMIN_TOKEN", ""),
+
+			RunBackgroundJobs: getEnv("RUN_BACKGROUND_JOBS", "false") == "true",
 		},
 		MongoDB: MongoDBConfig{
 			User:       getEnv("MONGO_USER", ""),
@@ -66,6 +81,10 @@ func Load() (*Config, error) {
 			Uri:        getEnv("MONGO_URI", ""),
 			Database:   getEnv("MONGO_DATABASE", ""),
 			Collection: getEnv("MONGO_COLLECTION", ""),
+
+			PriceHistoryCollection:       getEnv("MONGO_PRICE_HISTORY_COLLECTION", "price_history"),
+			SavedSearchesCollection:      getEnv("MONGO_SAVED_SEARCHES_COLLECTION", "saved_searches"),
+			SavedSearchMatchesCollection: getEnv("MONGO_SAVED_SEARCH_MATCHES_COLLECTION", "saved_search_matches"),
 		},
 		Cache: CacheConfig{
 			Host:     getEnv("CACHE_HOST", ""),
@@ -91,6 +110,23 @@ func Load() (*Config, error) {
 		return nil, errors.New("check env bucket r2 cannot be empty")
 	}
 
+	if config.Server.CursorSecret == "" {
+		secret, err := randomSecret()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate cursor secret: %w", err)
+		}
+		log.Println("CURSOR_SECRET is empty, using a random secret, cursors will not survive restarts")
+		config.Server.CursorSecret = secret
+	}
+
+	if !config.Server.RunBackgroundJobs {
+		log.Println("RUN_BACKGROUND_JOBS is not true, background jobs over the collection will not run on this instance")
+	}
+
+	if config.Server.AdminToken == "" {
+		log.Println("ADMIN_TOKEN is empty, admin endpoints will reject every request")
+	}
+
 	return config, nil
 
 }
@@ -110,6 +146,15 @@ func (c *ServerConfig) GetBindAddress() string {
 	return fmt.Sprintf("%s:%s", c.BindHost, c.Port)
 }
 
+// randomSecret generates a random hex secret used when no cursor secret is configured
+func randomSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
+
 // getEnv gets an environment variable or returns a default value
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
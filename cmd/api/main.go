@@ -4,7 +4,6 @@ import (
 	"context"
 	"log"
 	"net/http"
-	"os"
 	"os/signal"
 	"syscall"
 	"time"
@@ -20,6 +19,11 @@ import (
 
 // @BasePath  /api/v1/bikes
 
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Admin token as "Bearer <token>"
+
 func main() {
 
 	// Load configuration
@@ -38,6 +42,12 @@ func main() {
 		log.Fatalf("Failed to initialize container: %v", err)
 	}
 
+	// Background tasks stop with the same signals that shut down the server
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	app.Application.StartBackground(ctx, cfg.Server.RunBackgroundJobs)
+
 	// Setup router
 	router := app.Router.SetUp(cfg.Server.IsDevelopment())
 
@@ -60,17 +70,16 @@ func main() {
 	}()
 
 	// Wait for interrupt signal to gracefully shutdown the server
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
-	<-quit
+	<-ctx.Done()
+	stop()
 
 	log.Println("Shutting down server...")
 
 	// Graceful shutdown with timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if err := srv.Shutdown(ctx); err != nil {
+	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
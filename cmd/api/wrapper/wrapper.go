@@ -1,6 +1,7 @@
 package wrapper
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -18,47 +19,55 @@ type GetClientMongoFn func(configMongo config.MongoDBConfig) (ports.MongoClient,
 type GetClientR2Fn func(r2Credentials config.BucketR2Config) (ports.R2Client, error)
 type GetClientCacheFn func(capacity int, ttl time.Duration) ports.CacheClient[string, any]
 type NewCacheRepositoryFn func(client ports.CacheClient[string, any]) ports.CacheRepository[string, any]
-type NewMongoRepositoryFn func(client ports.MongoClient, collectionName string) ports.MongoRepository
+type NewMongoRepositoryFn func(client ports.MongoClient, collectionName, priceHistoryCollection string) ports.MongoRepository
+type NewSavedSearchRepositoryFn func(client ports.MongoClient, searchesCollection, matchesCollection string) ports.SavedSearchRepository
+type NewStatsRepositoryFn func(client ports.MongoClient, collectionName string) ports.StatsRepository
 type NewR2RepositoryFn func(client ports.R2Client) ports.R2Repository
-type NewApplicationFn func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) core.Application
+type NewApplicationFn func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any], savedSearchRepository ports.SavedSearchRepository, statsRepository ports.StatsRepository, cursorSecret string) core.Application
 type NewApiHandlerFn func(application core.Application) ports.ApiHandler
-type NewRoutesFn func(handlers ports.ApiHandler) ports.Router
+type NewRoutesFn func(handlers ports.ApiHandler, adminToken string) ports.Router
 
 type Wrapper struct {
-	Config             *config.Config
-	newApplication     NewApplicationFn
-	getClientMongo     GetClientMongoFn
-	getClientR2        GetClientR2Fn
-	getClientCache     GetClientCacheFn
-	newMongoRepository NewMongoRepositoryFn
-	newR2Repository    NewR2RepositoryFn
-	newCacheRepository NewCacheRepositoryFn
-	newApiHandler      NewApiHandlerFn
-	newRoutes          NewRoutesFn
+	Config                   *config.Config
+	newApplication           NewApplicationFn
+	getClientMongo           GetClientMongoFn
+	getClientR2              GetClientR2Fn
+	getClientCache           GetClientCacheFn
+	newMongoRepository       NewMongoRepositoryFn
+	newSavedSearchRepository NewSavedSearchRepositoryFn
+	newStatsRepository       NewStatsRepositoryFn
+	newR2Repository          NewR2RepositoryFn
+	newCacheRepository       NewCacheRepositoryFn
+	newApiHandler            NewApiHandlerFn
+	newRoutes                NewRoutesFn
 }
 
 func DefaultWrapper() *Wrapper {
 	return &Wrapper{
-		newApplication:     core.NewApplication,
-		getClientMongo:     mongo.GetClientMongo,
-		getClientR2:        r2.GetClientR2,
-		getClientCache:     cache.NewCacheClient,
-		newMongoRepository: mongo.NewMongoRepository,
-		newR2Repository:    r2.NewR2Repository,
-		newCacheRepository: cache.NewCacheRepository,
-		newApiHandler:      handlers.NewApiHandler,
-		newRoutes:          router.NewRouter,
+		newApplication:           core.NewApplication,
+		getClientMongo:           mongo.GetClientMongo,
+		getClientR2:              r2.GetClientR2,
+		getClientCache:           cache.NewCacheClient,
+		newMongoRepository:       mongo.NewMongoRepository,
+		newSavedSearchRepository: mongo.NewSavedSearchRepository,
+		newStatsRepository:       mongo.NewStatsRepository,
+		newR2Repository:          r2.NewR2Repository,
+		newCacheRepository:       cache.NewCacheRepository,
+		newApiHandler:            handlers.NewApiHandler,
+		newRoutes:                router.NewRouter,
 	}
 }
 
 type App struct {
-	Config          *config.Config
-	MongoRepository ports.MongoRepository
-	R2Repository    ports.R2Repository
-	CacheRepository ports.CacheRepository[string, any]
-	Application     core.Application
-	ApiHandler      ports.ApiHandler
-	Router          ports.Router
+	Config                *config.Config
+	MongoRepository       ports.MongoRepository
+	SavedSearchRepository ports.SavedSearchRepository
+	StatsRepository       ports.StatsRepository
+	R2Repository          ports.R2Repository
+	CacheRepository       ports.CacheRepository[string, any]
+	Application           core.Application
+	ApiHandler            ports.ApiHandler
+	Router                ports.Router
 }
 
 func NewApp(w *Wrapper, cfg *config.Config) (*App, error) {
@@ -75,7 +84,19 @@ func NewApp(w *Wrapper, cfg *config.Config) (*App, error) {
 
 	mongo.CheckHealth(clientMongo)
 
-	app.MongoRepository = w.newMongoRepository(clientMongo, cfg.MongoDB.Collection)
+	app.MongoRepository = w.newMongoRepository(clientMongo, cfg.MongoDB.Collection, cfg.MongoDB.PriceHistoryCollection)
+
+	if errIndex := app.MongoRepository.EnsureIndexes(context.Background()); errIndex != nil {
+		log.Printf("error creating mongo indexes: %v", errIndex.Message)
+	}
+
+	app.SavedSearchRepository = w.newSavedSearchRepository(clientMongo, cfg.MongoDB.SavedSearchesCollection, cfg.MongoDB.SavedSearchMatchesCollection)
+
+	if errIndex := app.SavedSearchRepository.EnsureIndexes(context.Background()); errIndex != nil {
+		log.Printf("error creating saved searches indexes: %v", errIndex.Message)
+	}
+
+	app.StatsRepository = w.newStatsRepository(clientMongo, cfg.MongoDB.Collection)
 
 	clientR2, err := w.getClientR2(cfg.BucketR2)
 
@@ -88,11 +109,11 @@ func NewApp(w *Wrapper, cfg *config.Config) (*App, error) {
 	cacheClient := w.getClientCache(1000, 90)
 	app.CacheRepository = w.newCacheRepository(cacheClient)
 
-	app.Application = w.newApplication(app.MongoRepository, app.R2Repository, app.CacheRepository)
+	app.Application = w.newApplication(app.MongoRepository, app.R2Repository, app.CacheRepository, app.SavedSearchRepository, app.StatsRepository, cfg.Server.CursorSecret)
 
 	app.ApiHandler = w.newApiHandler(app.Application)
 
-	app.Router = w.newRoutes(app.ApiHandler)
+	app.Router = w.newRoutes(app.ApiHandler, cfg.Server.AdminToken)
 
 	return app, nil
 }
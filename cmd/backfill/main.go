@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/Bikes2Road/bikes-compass/cmd/api/config"
+	"github.com/Bikes2Road/bikes-compass/internal/adapters/mongo"
+	"github.com/Bikes2Road/bikes-compass/internal/adapters/r2"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	"github.com/Bikes2Road/bikes-compass/internal/core/services"
+)
+
+// jobs relaciona el nombre recibido en -job con su constructor
+var jobs = map[string]func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill{
+	"geo": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewBackfillGeo(mongoRepository)
+	},
+	"deals": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewRefreshDealScores(mongoRepository)
+	},
+	"specs": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewBackfillSpecs(mongoRepository)
+	},
+	"documents": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewBackfillDocuments(mongoRepository)
+	},
+	"extras": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewBackfillExtras(mongoRepository)
+	},
+	"duplicates": func(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) ports.Backfill {
+		return services.NewDetectDuplicates(mongoRepository, r2Repository)
+	},
+}
+
+func main() {
+	jobName := flag.String("job", "", "backfill job to run: geo, deals, duplicates, specs, documents, extras")
+	flag.Parse()
+
+	newJob, ok := jobs[*jobName]
+	if !ok {
+		log.Fatalf("Unknown backfill job %q", *jobName)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	clientMongo, err := mongo.GetClientMongo(cfg.MongoDB)
+	if err != nil {
+		log.Fatalf("Failed to connect to mongo: %v", err)
+	}
+
+	mongoRepository := mongo.NewMongoRepository(clientMongo, cfg.MongoDB.Collection, cfg.MongoDB.PriceHistoryCollection)
+
+	clientR2, err := r2.GetClientR2(cfg.BucketR2)
+	if err != nil {
+		log.Fatalf("Failed to load R2 config: %v", err)
+	}
+
+	r2Repository := r2.NewR2Repository(clientR2)
+
+	ctx := context.Background()
+	if errIndex := mongoRepository.EnsureIndexes(ctx); errIndex != nil {
+		log.Fatalf("Failed to create indexes: %v", errIndex.Message)
+	}
+
+	log.Printf("Running backfill job %s...", *jobName)
+
+	result, errJob := newJob(mongoRepository, r2Repository).Execute(ctx)
+	if result != nil {
+		log.Printf("Backfill %s: processed=%d updated=%d skipped=%d", *jobName, result.Processed, result.Updated, result.Skipped)
+	}
+	if errJob != nil {
+		log.Fatalf("Backfill %s failed: %v", *jobName, errJob.Message)
+	}
+}
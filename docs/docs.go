@@ -15,30 +15,161 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/byke/{hash_byke}": {
-            "get": {
-                "description": "This service extract all data from a Byke by Hash_Byke",
+        "/admin/byke": {
+            "post": {
+                "description": "This service creates a listing with a new hash_byke, pending of review (reviewed=false) and active unless active is false. Photos need a first group with at least one photo. Spec, extras, document and location fields used by /search are derived from the payload",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Bikes 2 Road"
+                    "Admin"
                 ],
-                "summary": "Search Byke by Hash",
+                "summary": "Create Byke",
+                "parameters": [
+                    {
+                        "description": "Listing to create",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateBykeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateBykeResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/admin/byke/{hash_byke}": {
+            "delete": {
+                "description": "This service deletes a listing and clears its cached detail and the cached responses built from several listings",
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Delete Byke",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hash of Byke that you want extract",
+                        "description": "Hash of Byke that you want delete",
+                        "name": "hash_byke",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            },
+            "patch": {
+                "description": "This service edits the fields sent of a listing, other fields are rejected. The version read from /byke/{hash_byke} (ETag) must be sent in If-Match or as version, and it fails with 412 if the listing changed since then. Derived spec, extras, document and location fields are recalculated and the cached detail of the listing and the cached responses built from several listings are cleared",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "summary": "Update Byke",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hash of Byke that you want edit",
                         "name": "hash_byke",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Version of the byke, required unless version is sent in the body",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to edit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.UpdateBykeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.GetBykeResponseSuccess"
+                            "$ref": "#/definitions/domain.UpdateBykeResponseSuccess"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "New version of the byke"
+                            }
                         }
                     },
                     "400": {
@@ -59,59 +190,125 @@ const docTemplate = `{
                             "$ref": "#/definitions/domain.ResponseHttpError"
                         }
                     },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "428": {
+                        "description": "Precondition Required",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/domain.ResponseHttpError"
                         }
                     }
-                }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
             }
         },
-        "/health": {
+        "/admin/duplicates": {
             "get": {
-                "description": "This service returns OK status to verify the microservice is running",
+                "description": "This service returns the groups of active listings detected as the same bike published by several pages, the canonical listing is the one shown in /search",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Health"
+                    "Admin"
                 ],
-                "summary": "Health Check",
+                "summary": "Search Duplicate Clusters",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.HealthResponse"
+                            "$ref": "#/definitions/domain.DuplicateClustersResponseSuccess"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ]
+            }
+        },
+        "/brands": {
+            "get": {
+                "description": "This service lists the brands with active bikes, with the number of bikes and the range of model years of each one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Brands",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BrandsResponseSuccess"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
                         }
                     }
                 }
             }
         },
-        "/placeholder": {
+        "/brands/{brand}/models": {
             "get": {
-                "description": "This service extract a list of name bikes from a Byke by name",
+                "description": "This service lists the models of a brand with active bikes, with the number of bikes and the range of model years of each one. The brand is matched without case or accents",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Bikes 2 Road"
                 ],
-                "summary": "Search Byke by Hash",
+                "summary": "Search Models of a Brand",
                 "parameters": [
                     {
                         "type": "string",
-                        "example": "Yamaha",
-                        "description": "name of byke that you want search",
-                        "name": "name",
-                        "in": "query"
+                        "description": "Name of the brand",
+                        "name": "brand",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.PlaceHolderResponseSuccess"
+                            "$ref": "#/definitions/domain.ModelsResponseSuccess"
                         }
                     },
                     "400": {
@@ -141,51 +338,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/search": {
-            "get": {
-                "description": "This service extract all bikes with pagination, you can search bikes by name, or all bikes",
+        "/byke/batch": {
+            "post": {
+                "description": "This service extract all data from up to 50 Bykes by Hash_Byke in one request, hashes not found are returned in missing",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "Bikes 2 Road"
                 ],
-                "summary": "Search Bikes",
+                "summary": "Search Bykes by Hashes",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "page that you want extract",
-                        "name": "page",
-                        "in": "query"
+                        "description": "Hashes of Bykes that you want extract",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.BatchBikesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.BatchBikesResponseSuccess"
+                        }
                     },
-                    {
-                        "maximum": 30,
-                        "type": "integer",
-                        "description": "cant bikes you want extract",
-                        "name": "cant",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
                     },
-                    {
-                        "type": "string",
-                        "example": "BMW M1000RR",
-                        "description": "name of byke that you want search",
-                        "name": "name",
-                        "in": "query"
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/byke/{hash_byke}": {
+            "get": {
+                "description": "This service extract all data from a Byke by Hash_Byke",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Byke by Hash",
+                "parameters": [
                     {
                         "type": "string",
-                        "example": "BMW",
-                        "description": "brand of byke that you want search",
-                        "name": "brand",
-                        "in": "query"
+                        "description": "Hash of Byke that you want extract",
+                        "name": "hash_byke",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/domain.GetAllResponseSuccess"
+                            "$ref": "#/definitions/domain.GetBykeResponseSuccess"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the byke, send it in If-Match to edit it"
+                            }
                         }
                     },
                     "400": {
@@ -214,37 +448,2396 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "domain.GetAllResponseSuccess": {
-            "type": "object",
-            "required": [
-                "data",
-                "success",
-                "total"
-            ],
-            "properties": {
-                "data": {
-                    "description": "Lista de motos encontradas",
-                    "type": "array",
-                    "items": {
-                        "type": "object"
-                    }
-                },
-                "success": {
-                    "description": "Indica si la petición fue exitosa",
+        },
+        "/byke/{hash_byke}/price-history": {
+            "get": {
+                "description": "This service returns the price changes of a Byke from the oldest to the newest and its current price",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Price History of Byke",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hash of Byke that you want extract",
+                        "name": "hash_byke",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PriceHistoryResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/byke/{hash_byke}/similar": {
+            "get": {
+                "description": "This service returns the active bikes most similar to a Byke, weighted by brand, model, cylinder class, year, km and price",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Similar Bikes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hash of Byke that you want compare",
+                        "name": "hash_byke",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 30,
+                        "type": "integer",
+                        "default": 6,
+                        "description": "cant similar bikes you want extract",
+                        "name": "cant",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SimilarBikesResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/compare": {
+            "get": {
+                "description": "This service compares 2 to 4 Bykes side by side, with aligned spec rows and the best value of each row marked",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Compare Bikes",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Hash of each Byke that you want compare",
+                        "name": "hash",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.CompareResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/extras": {
+            "get": {
+                "description": "This service lists the equipment tags that can be used in the extras filter of /search, with the number of active bikes that have each one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Extra Tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ExtraTagsResponseSuccess"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/facets": {
+            "get": {
+                "description": "This service counts active bikes by brand, location, year model and price/km ranges, it accepts the same filters as /search",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Facets",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "BMW M1000RR",
+                        "description": "name of byke that you want search",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BMW",
+                        "description": "brand of byke that you want search",
+                        "name": "brand",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum price of byke",
+                        "name": "price_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum price of byke",
+                        "name": "price_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum year model of byke",
+                        "name": "year_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum year model of byke",
+                        "name": "year_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum kilometers of byke",
+                        "name": "km_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum cylinder of byke in cc",
+                        "name": "cc_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum cylinder of byke in cc",
+                        "name": "cc_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum horse power of byke in hp",
+                        "name": "hp_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only bykes with SOAT and tecnico-mecanica not expired",
+                        "name": "docs_valid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tags from /extras separated by commas, bykes must have all of them",
+                        "name": "extras",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Medellín",
+                        "description": "city name or DANE code of the municipality",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Antioquia",
+                        "description": "department name or DANE code",
+                        "name": "department",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 6.2442,
+                        "description": "latitude of the point for near me search",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": -75.5812,
+                        "description": "longitude of the point for near me search",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "number",
+                        "description": "radius in km around lat and lng",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "great,good",
+                        "description": "deal scores separated by commas (great, good, fair, high)",
+                        "name": "deal",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "substring",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "substring",
+                        "description": "how name is matched, text uses the text index",
+                        "name": "mode",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.FacetsResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "This service returns OK status to verify the microservice is running",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Health Check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/locations": {
+            "get": {
+                "description": "This service lists the municipalities of the DANE catalog that have active bikes, with the number of bikes in each one",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Locations",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.LocationsResponseSuccess"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/placeholder": {
+            "get": {
+                "description": "This service suggests brands, models and full names for a text, tolerating typos and ranked by number of listings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Byke by Hash",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "Yamaha",
+                        "description": "name of byke that you want search",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.PlaceHolderResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches": {
+            "post": {
+                "description": "This service saves the filters of /search for an owner, new active bikes that match them are recorded as matches",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Save Search",
+                "parameters": [
+                    {
+                        "description": "Owner and filters of the search",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/domain.CreateSavedSearchRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SavedSearchResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/saved-searches/{id}/matches": {
+            "get": {
+                "description": "This service returns the bikes that matched a saved search of the owner, send the id of the last match as after to poll for new ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Saved Searches"
+                ],
+                "summary": "Search Saved Search Matches",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Id of the saved search",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Owner of the saved search",
+                        "name": "owner_id",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "id of the last match received, only matches after it are returned",
+                        "name": "after",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "type": "integer",
+                        "default": 30,
+                        "description": "cant matches you want extract",
+                        "name": "cant",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.SavedSearchMatchesResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "This service extract all bikes with pagination, you can search bikes by name, or all bikes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Bikes",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "page that you want extract",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "type": "integer",
+                        "description": "cant bikes you want extract",
+                        "name": "cant",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BMW M1000RR",
+                        "description": "name of byke that you want search",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "BMW",
+                        "description": "brand of byke that you want search",
+                        "name": "brand",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum price of byke",
+                        "name": "price_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum price of byke",
+                        "name": "price_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum year model of byke",
+                        "name": "year_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum year model of byke",
+                        "name": "year_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum kilometers of byke",
+                        "name": "km_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum cylinder of byke in cc",
+                        "name": "cc_min",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "maximum cylinder of byke in cc",
+                        "name": "cc_max",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "minimum horse power of byke in hp",
+                        "name": "hp_min",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only bykes with SOAT and tecnico-mecanica not expired",
+                        "name": "docs_valid",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tags from /extras separated by commas, bykes must have all of them",
+                        "name": "extras",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Medellín",
+                        "description": "city name or DANE code of the municipality",
+                        "name": "city",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Antioquia",
+                        "description": "department name or DANE code",
+                        "name": "department",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": 6.2442,
+                        "description": "latitude of the point for near me search",
+                        "name": "lat",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "example": -75.5812,
+                        "description": "longitude of the point for near me search",
+                        "name": "lng",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1000,
+                        "minimum": 1,
+                        "type": "number",
+                        "description": "radius in km around lat and lng",
+                        "name": "radius_km",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "great,good",
+                        "description": "deal scores separated by commas (great, good, fair, high)",
+                        "name": "deal",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "substring",
+                            "text"
+                        ],
+                        "type": "string",
+                        "default": "substring",
+                        "description": "how name is matched, text uses the text index ranked by relevance",
+                        "name": "mode",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "price_asc",
+                            "price_desc",
+                            "newest",
+                            "km_asc",
+                            "year_desc",
+                            "deal",
+                            "relevance"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "order of results, relevance only with mode text",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "opaque cursor returned as next_cursor, when present page is ignored",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.GetAllResponseSuccess"
+                        },
+                        "headers": {
+                            "Link": {
+                                "type": "string",
+                                "description": "Pagination links (first, prev, next, last)"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/stats": {
+            "get": {
+                "description": "This service returns the new reviewed listings per day and ISO week (by date_publish, or date_found when missing), the average and median price by brand and year, the km distribution and the active and inactive listings of a date range",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Search Catalog Stats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "first day of the range (YYYY-MM-DD), 30 days before to by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "last day of the range (YYYY-MM-DD), today by default",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "brand of the listings",
+                        "name": "brand",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.StatsResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        },
+        "/valuation": {
+            "get": {
+                "description": "This service estimates the fair price of a Byke from comparable reviewed listings (active and sold): segment median and IQR adjusted by year and km depreciation",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Bikes 2 Road"
+                ],
+                "summary": "Estimate Market Value",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "Yamaha",
+                        "description": "brand of byke",
+                        "name": "brand",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "example": "MT-03",
+                        "description": "model of byke",
+                        "name": "model",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "year model of byke",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "kilometers of byke",
+                        "name": "km",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ValuationResponseSuccess"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/domain.ResponseHttpError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "domain.BatchBikesRequest": {
+            "type": "object",
+            "required": [
+                "hashes"
+            ],
+            "properties": {
+                "hashes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "domain.BatchBikesResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "missing",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Motos encontradas en el orden en que se pidieron",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FullBykeResponse"
+                    }
+                },
+                "missing": {
+                    "description": "Hashes que no se encontraron",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número de motos encontradas",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "domain.Bike": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "brand": {
+                    "type": "string"
+                },
+                "city_register": {
+                    "type": "string"
+                },
+                "cylinder": {
+                    "type": "string"
+                },
+                "cylinder_cc": {
+                    "type": "number"
+                },
+                "date_found": {
+                    "type": "integer"
+                },
+                "date_publish": {
+                    "type": "integer"
+                },
+                "date_soat": {
+                    "type": "string"
+                },
+                "date_tecnico": {
+                    "type": "string"
+                },
+                "deal_percentage": {
+                    "type": "number"
+                },
+                "deal_score": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "duplicate_of": {
+                    "type": "string"
+                },
+                "engine": {
+                    "type": "string"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "extras_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "full_name": {
+                    "type": "string"
+                },
+                "geo": {
+                    "$ref": "#/definitions/domain.GeoPoint"
+                },
+                "hash_byke": {
+                    "type": "string"
+                },
+                "horse_power": {
+                    "type": "string"
+                },
+                "horse_power_hp": {
+                    "type": "number"
+                },
+                "km": {
+                    "type": "integer"
+                },
+                "location": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "page_instagram": {
+                    "type": "string"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/domain.Photo"
+                        }
+                    }
+                },
+                "previous_price": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "integer"
+                },
+                "price_dropped": {
+                    "type": "boolean"
+                },
+                "ref": {
+                    "type": "string"
+                },
+                "reviewed": {
+                    "type": "boolean"
+                },
+                "soat_expires_at": {
+                    "type": "integer"
+                },
+                "tecnico_expires_at": {
+                    "type": "integer"
+                },
+                "torque": {
+                    "type": "string"
+                },
+                "torque_nm": {
+                    "type": "number"
+                },
+                "url_post": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "type": "string"
+                },
+                "weight_kg": {
+                    "type": "number"
+                },
+                "year_model": {
+                    "type": "integer"
+                }
+            }
+        },
+        "domain.BikeComparison": {
+            "type": "object",
+            "required": [
+                "bikes",
+                "missing",
+                "rows"
+            ],
+            "properties": {
+                "bikes": {
+                    "description": "Motos en el orden en que se pidieron",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FullBykeResponse"
+                    }
+                },
+                "missing": {
+                    "description": "Hashes que no se encontraron",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rows": {
+                    "description": "Una fila por especificación con un valor por moto",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ComparisonRow"
+                    }
+                }
+            }
+        },
+        "domain.BikeFacets": {
+            "type": "object",
+            "properties": {
+                "brands": {
+                    "description": "Conteo por marca",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.FacetCount"
+                    }
+                },
+                "km": {
+                    "description": "Conteo por rango de kilometraje",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RangeFacetCount"
+                    }
+                },
+                "locations": {
+                    "description": "Conteo por municipio del catálogo DANE, ver /locations",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LocationCount"
+                    }
+                },
+                "prices": {
+                    "description": "Conteo por rango de precio",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RangeFacetCount"
+                    }
+                },
+                "years": {
+                    "description": "Conteo por año del modelo",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.YearFacetCount"
+                    }
+                }
+            }
+        },
+        "domain.BrandsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Marcas con la cantidad de motos y el rango de años",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CatalogEntry"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de marcas",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.CatalogEntry": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Cantidad de motos publicadas",
+                    "type": "integer",
+                    "example": 42
+                },
+                "name": {
+                    "description": "Nombre de la marca o el modelo",
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "year_max": {
+                    "description": "Año del modelo más reciente",
+                    "type": "integer",
+                    "example": 2024
+                },
+                "year_min": {
+                    "description": "Año del modelo más antiguo",
+                    "type": "integer",
+                    "example": 2012
+                }
+            }
+        },
+        "domain.CatalogStats": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Publicaciones activas",
+                    "type": "integer",
+                    "example": 90
+                },
+                "brand": {
+                    "description": "Marca filtrada",
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "from": {
+                    "description": "Fecha inicial del rango (YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2025-01-01"
+                },
+                "inactive": {
+                    "description": "Publicaciones inactivas (vendidas o retiradas)",
+                    "type": "integer",
+                    "example": 30
+                },
+                "km": {
+                    "description": "Distribución por rango de kilometraje",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.RangeFacetCount"
+                    }
+                },
+                "listings_per_day": {
+                    "description": "Publicaciones nuevas por día",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PeriodCount"
+                    }
+                },
+                "listings_per_week": {
+                    "description": "Publicaciones nuevas por semana ISO",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PeriodCount"
+                    }
+                },
+                "price_by_brand": {
+                    "description": "Precio promedio y mediana por marca",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PriceStats"
+                    }
+                },
+                "price_by_year": {
+                    "description": "Precio promedio y mediana por año del modelo",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PriceStats"
+                    }
+                },
+                "to": {
+                    "description": "Fecha final del rango, incluida (YYYY-MM-DD)",
+                    "type": "string",
+                    "example": "2025-01-31"
+                },
+                "total": {
+                    "description": "Publicaciones en el rango",
+                    "type": "integer",
+                    "example": 120
+                }
+            }
+        },
+        "domain.CompareResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Motos comparadas y filas de especificaciones alineadas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BikeComparison"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.ComparisonRow": {
+            "type": "object",
+            "properties": {
+                "better": {
+                    "description": "Indica si el mejor valor es el mayor (max) o el menor (min), vacío si la fila no se compara",
+                    "type": "string",
+                    "example": "max"
+                },
+                "spec": {
+                    "description": "Especificación comparada",
+                    "type": "string",
+                    "example": "horse_power"
+                },
+                "unit": {
+                    "description": "Unidad del valor numérico",
+                    "type": "string",
+                    "example": "hp"
+                },
+                "values": {
+                    "description": "Valores en el mismo orden de las motos",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ComparisonValue"
+                    }
+                }
+            }
+        },
+        "domain.ComparisonValue": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "description": "Indica si es el mejor valor de la fila",
+                    "type": "boolean",
+                    "example": true
+                },
+                "hash_byke": {
+                    "description": "Hash de la moto",
+                    "type": "string",
+                    "example": "abcd1234abcd"
+                },
+                "raw": {
+                    "description": "Valor publicado",
+                    "type": "string",
+                    "example": "42 CV"
+                },
+                "value": {
+                    "description": "Valor convertido a la unidad de la fila, null si no se pudo interpretar",
+                    "type": "number",
+                    "example": 41.4
+                }
+            }
+        },
+        "domain.CreateBykeRequest": {
+            "type": "object",
+            "required": [
+                "brand",
+                "full_name",
+                "km",
+                "location",
+                "model",
+                "page_instagram",
+                "price",
+                "url_post",
+                "year_model"
+            ],
+            "properties": {
+                "active": {
+                    "description": "Por defecto la publicacion se crea activa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "brand": {
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "city_register": {
+                    "type": "string",
+                    "example": "Envigado"
+                },
+                "cylinder": {
+                    "type": "string",
+                    "example": "889 cc"
+                },
+                "date_publish": {
+                    "type": "integer",
+                    "example": 1731081212
+                },
+                "date_soat": {
+                    "type": "string",
+                    "example": "15/03/2026"
+                },
+                "date_tecnico": {
+                    "type": "string",
+                    "example": "03/2026"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Unico dueño, mantenimientos en concesionario"
+                },
+                "engine": {
+                    "type": "string",
+                    "example": "Tricilindrico 4T"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "ABS",
+                        "maletas laterales"
+                    ]
+                },
+                "full_name": {
+                    "type": "string",
+                    "example": "Yamaha MT-09"
+                },
+                "horse_power": {
+                    "type": "string",
+                    "example": "117 HP @ 10000rpm"
+                },
+                "km": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Medellín - Antioquia"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "MT-09"
+                },
+                "page_instagram": {
+                    "type": "string",
+                    "example": "motos_medellin"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "object"
+                        }
+                    }
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 45000000
+                },
+                "ref": {
+                    "type": "string",
+                    "example": "1234"
+                },
+                "torque": {
+                    "type": "string",
+                    "example": "93 Nm"
+                },
+                "url_post": {
+                    "type": "string",
+                    "example": "https://www.instagram.com/p/abc123"
+                },
+                "weight": {
+                    "type": "string",
+                    "example": "189 kg"
+                },
+                "year_model": {
+                    "type": "integer",
+                    "example": 2021
+                }
+            }
+        },
+        "domain.CreateBykeResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Publicación creada, pendiente de revisión",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Bike"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.CreateSavedSearchRequest": {
+            "type": "object",
+            "required": [
+                "owner_id"
+            ],
+            "properties": {
+                "filters": {
+                    "$ref": "#/definitions/domain.SearchFilters"
+                },
+                "owner_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.Depreciation": {
+            "type": "object",
+            "properties": {
+                "per_1000_km": {
+                    "description": "Cuánto baja el precio por cada 1000 km",
+                    "type": "integer",
+                    "example": 80000
+                },
+                "per_year": {
+                    "description": "Cuánto sube el precio por cada año más nuevo",
+                    "type": "integer",
+                    "example": 1200000
+                }
+            }
+        },
+        "domain.DuplicateCluster": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "description": "Hash de la publicación canónica, es el id del grupo",
+                    "type": "string",
+                    "example": "abcd1234abcd"
+                },
+                "members": {
+                    "description": "Publicaciones del grupo, la canónica primero",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DuplicateMember"
+                    }
+                }
+            }
+        },
+        "domain.DuplicateClustersResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Grupos de publicaciones que son la misma moto",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.DuplicateCluster"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de grupos",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.DuplicateMember": {
+            "type": "object",
+            "properties": {
+                "canonical": {
+                    "description": "Indica si es la publicación que se muestra en /search",
+                    "type": "boolean",
+                    "example": true
+                },
+                "date_publish": {
+                    "type": "integer",
+                    "example": 1731081212
+                },
+                "full_name": {
+                    "type": "string",
+                    "example": "Yamaha MT-09"
+                },
+                "hash_byke": {
+                    "type": "string",
+                    "example": "abcd1234abcd"
+                },
+                "km": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Medellín - Antioquia"
+                },
+                "page_instagram": {
+                    "type": "string",
+                    "example": "motos_medellin"
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 45000000
+                },
+                "url_post": {
+                    "type": "string",
+                    "example": "https://www.instagram.com/p/abc123"
+                },
+                "year_model": {
+                    "type": "integer",
+                    "example": 2021
+                }
+            }
+        },
+        "domain.ExtraTagCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Cantidad de motos publicadas",
+                    "type": "integer",
+                    "example": 12
+                },
+                "label": {
+                    "description": "Nombre para mostrar",
+                    "type": "string",
+                    "example": "Maletas laterales"
+                },
+                "tag": {
+                    "description": "Etiqueta canónica, es el valor del filtro extras",
+                    "type": "string",
+                    "example": "side_cases"
+                }
+            }
+        },
+        "domain.ExtraTagsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Etiquetas con la cantidad de motos publicadas que las tienen",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.ExtraTagCount"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de etiquetas",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.FacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Yamaha"
+                }
+            }
+        },
+        "domain.FacetsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Conteos por marca, ubicación, año, precio y kilometraje",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.BikeFacets"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.FullBykeResponse": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string"
+                },
+                "city_register": {
+                    "type": "string"
+                },
+                "cylinder": {
+                    "type": "string"
+                },
+                "cylinder_cc": {
+                    "description": "Cilindraje en cc interpretado de cylinder",
+                    "type": "number",
+                    "example": 689
+                },
+                "date_found": {
+                    "type": "integer"
+                },
+                "date_publish": {
+                    "type": "integer",
+                    "example": 1731081212
+                },
+                "date_soat": {
+                    "type": "string"
+                },
+                "date_tecnico": {
+                    "type": "string"
+                },
+                "deal_percentage": {
+                    "description": "Porcentaje del precio frente al precio estimado de mercado, negativo es más barato",
+                    "type": "number",
+                    "example": -8.5
+                },
+                "deal_score": {
+                    "description": "Calificación del precio frente al mercado: great, good, fair o high",
+                    "type": "string",
+                    "example": "good"
+                },
+                "engine": {
+                    "type": "string"
+                },
+                "extras": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "extras_tags": {
+                    "description": "Etiquetas canónicas del equipamiento, ver /extras",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "abs",
+                        "side_cases"
+                    ]
+                },
+                "full_name": {
+                    "type": "string",
+                    "example": "Yamaha MT-03"
+                },
+                "hash_byke": {
+                    "type": "string",
+                    "example": "abcd1234"
+                },
+                "horse_power": {
+                    "type": "string"
+                },
+                "horse_power_hp": {
+                    "description": "Potencia en hp interpretada de horse_power",
+                    "type": "number",
+                    "example": 73.4
+                },
+                "km": {
+                    "type": "integer",
+                    "example": 1235
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Bogotá D.C"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "page_instagram": {
+                    "type": "string"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "object"
+                        }
+                    }
+                },
+                "previous_price": {
+                    "type": "integer",
+                    "example": 27000000
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 25000000
+                },
+                "price_dropped": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "ref": {
+                    "type": "string",
+                    "example": "1234"
+                },
+                "soat_days_left": {
+                    "description": "Días que faltan para que venza el SOAT, negativo si ya venció",
+                    "type": "integer",
+                    "example": 120
+                },
+                "soat_status": {
+                    "description": "Estado del SOAT: valid, expiring, expired o unknown",
+                    "type": "string",
+                    "example": "valid"
+                },
+                "tecnico_days_left": {
+                    "description": "Días que faltan para que venza la técnico-mecánica, negativo si ya venció",
+                    "type": "integer",
+                    "example": 12
+                },
+                "tecnico_status": {
+                    "description": "Estado de la revisión técnico-mecánica: valid, expiring, expired o unknown",
+                    "type": "string",
+                    "example": "expiring"
+                },
+                "torque": {
+                    "type": "string"
+                },
+                "torque_nm": {
+                    "description": "Torque en Nm interpretado de torque",
+                    "type": "number",
+                    "example": 68
+                },
+                "url_post": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Versión de la publicación, se envía en If-Match para editarla desde la administración",
+                    "type": "integer",
+                    "example": 3
+                },
+                "weight": {
+                    "type": "string"
+                },
+                "weight_kg": {
+                    "description": "Peso en kg interpretado de weight",
+                    "type": "number",
+                    "example": 189
+                },
+                "year_model": {
+                    "type": "integer",
+                    "example": 2020
+                }
+            }
+        },
+        "domain.GeoPoint": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "domain.GetAllResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "has_next",
+                "page",
+                "page_size",
+                "success",
+                "total",
+                "total_pages"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Lista de motos encontradas",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "has_next": {
+                    "description": "Indica si existe una página siguiente",
+                    "type": "boolean",
+                    "example": false
+                },
+                "next_cursor": {
+                    "description": "Cursor opaco para solicitar la siguiente página",
+                    "type": "string",
+                    "example": "eyJzIjoibmV3ZXN0In0.c2lnbmF0dXJl"
+                },
+                "page": {
+                    "description": "Página actual",
+                    "type": "integer",
+                    "example": 1
+                },
+                "page_size": {
+                    "description": "Cantidad de registros por página",
+                    "type": "integer",
+                    "example": 10
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de registros que coinciden con la búsqueda",
+                    "type": "integer",
+                    "example": 10
+                },
+                "total_pages": {
+                    "description": "Número total de páginas",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.GetBykeResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Lista de motos encontradas",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de registros encontrados",
+                    "type": "integer",
+                    "example": 10
+                }
+            }
+        },
+        "domain.HealthResponse": {
+            "type": "object",
+            "required": [
+                "message",
+                "success"
+            ],
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "OK"
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.LocationCount": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "Nombre del municipio",
+                    "type": "string",
+                    "example": "Bogotá D.C."
+                },
+                "code": {
+                    "description": "Código DANE del municipio",
+                    "type": "string",
+                    "example": "11001"
+                },
+                "count": {
+                    "description": "Cantidad de motos publicadas",
+                    "type": "integer",
+                    "example": 42
+                },
+                "department": {
+                    "description": "Nombre del departamento",
+                    "type": "string",
+                    "example": "Bogotá D.C."
+                },
+                "department_code": {
+                    "description": "Código DANE del departamento",
+                    "type": "string",
+                    "example": "11"
+                }
+            }
+        },
+        "domain.LocationsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Municipios con la cantidad de motos publicadas",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.LocationCount"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de municipios",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.ModelsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "brand",
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "brand": {
+                    "description": "Marca consultada",
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "data": {
+                    "description": "Modelos con la cantidad de motos y el rango de años",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.CatalogEntry"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número total de modelos",
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "domain.PeriodCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "period": {
+                    "type": "string",
+                    "example": "2025-01-15"
+                }
+            }
+        },
+        "domain.Photo": {
+            "type": "object",
+            "properties": {
+                "height": {
+                    "description": "Altura de la imagen",
+                    "type": "integer",
+                    "example": 123
+                },
+                "key": {
+                    "description": "Clave o ruta en almacenamiento",
+                    "type": "string",
+                    "example": "/key/photo"
+                },
+                "url": {
+                    "description": "URL pública de la foto",
+                    "type": "string",
+                    "example": "http://photo_url.test"
+                },
+                "width": {
+                    "description": "Ancho de la imagen",
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "domain.PlaceHolderResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PlaceHolderSuggestion"
+                    }
+                },
+                "success": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "domain.PlaceHolderSuggestion": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Categoría de la sugerencia: brand, model o full_name",
+                    "type": "string",
+                    "example": "brand"
+                },
+                "count": {
+                    "description": "Cantidad de publicaciones que tienen este valor",
+                    "type": "integer",
+                    "example": 42
+                },
+                "value": {
+                    "description": "Texto sugerido",
+                    "type": "string",
+                    "example": "Yamaha"
+                }
+            }
+        },
+        "domain.PriceChange": {
+            "type": "object",
+            "properties": {
+                "changed_at": {
+                    "description": "Fecha del cambio (timestamp)",
+                    "type": "integer",
+                    "example": 1731081212
+                },
+                "hash_byke": {
+                    "description": "Hash de la moto",
+                    "type": "string",
+                    "example": "abcd1234abcd"
+                },
+                "new_price": {
+                    "description": "Precio después del cambio",
+                    "type": "integer",
+                    "example": 25000000
+                },
+                "old_price": {
+                    "description": "Precio antes del cambio",
+                    "type": "integer",
+                    "example": 27000000
+                }
+            }
+        },
+        "domain.PriceHistoryResponseSuccess": {
+            "type": "object",
+            "required": [
+                "current_price",
+                "data",
+                "success",
+                "total"
+            ],
+            "properties": {
+                "current_price": {
+                    "description": "Precio actual de la moto",
+                    "type": "integer",
+                    "example": 25000000
+                },
+                "data": {
+                    "description": "Cambios de precio del más antiguo al más reciente",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/domain.PriceChange"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número de cambios de precio",
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "domain.PriceStats": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "integer",
+                    "example": 24500000
+                },
+                "count": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "group": {
+                    "description": "Marca o año del grupo",
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "median": {
+                    "type": "integer",
+                    "example": 23000000
+                }
+            }
+        },
+        "domain.RangeFacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "max": {
+                    "type": "integer",
+                    "example": 20000000
+                },
+                "min": {
+                    "type": "integer",
+                    "example": 10000000
+                }
+            }
+        },
+        "domain.ResponseHttpError": {
+            "type": "object",
+            "required": [
+                "code",
+                "error",
+                "message",
+                "success"
+            ],
+            "properties": {
+                "code": {
+                    "description": "Código de error HTTP",
+                    "type": "integer",
+                    "example": 400
+                },
+                "error": {
+                    "description": "Identificador del tipo de error",
+                    "type": "string",
+                    "example": "bad_request"
+                },
+                "message": {
+                    "description": "Mensaje descriptivo del error",
+                    "type": "string",
+                    "example": "error with request"
+                },
+                "success": {
+                    "description": "Indica si la operación fue exitosa (en este caso false)",
                     "type": "boolean",
-                    "example": true
+                    "example": false
+                }
+            }
+        },
+        "domain.SavedSearch": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1731081212
                 },
-                "total": {
-                    "description": "Número total de registros encontrados",
+                "filters": {
+                    "$ref": "#/definitions/domain.SearchFilters"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6650c0d2f1a2b3c4d5e6f708"
+                },
+                "owner_id": {
+                    "type": "string",
+                    "example": "user-123"
+                }
+            }
+        },
+        "domain.SavedSearchMatch": {
+            "type": "object",
+            "properties": {
+                "full_name": {
+                    "type": "string",
+                    "example": "Yamaha MT-03"
+                },
+                "hash_byke": {
+                    "type": "string",
+                    "example": "abcd1234abcd"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "6650c0d2f1a2b3c4d5e6f709"
+                },
+                "matched_at": {
                     "type": "integer",
-                    "example": 10
+                    "example": 1731081212
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 25000000
+                },
+                "search_id": {
+                    "type": "string",
+                    "example": "6650c0d2f1a2b3c4d5e6f708"
                 }
             }
         },
-        "domain.GetBykeResponseSuccess": {
+        "domain.SavedSearchMatchesResponseSuccess": {
             "type": "object",
             "required": [
                 "data",
@@ -253,10 +2846,10 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
-                    "description": "Lista de motos encontradas",
+                    "description": "Coincidencias ordenadas de la más antigua a la más reciente",
                     "type": "array",
                     "items": {
-                        "type": "object"
+                        "$ref": "#/definitions/domain.SavedSearchMatch"
                     }
                 },
                 "success": {
@@ -265,30 +2858,116 @@ const docTemplate = `{
                     "example": true
                 },
                 "total": {
-                    "description": "Número total de registros encontrados",
+                    "description": "Número de coincidencias retornadas",
                     "type": "integer",
-                    "example": 10
+                    "example": 3
                 }
             }
         },
-        "domain.HealthResponse": {
+        "domain.SavedSearchResponseSuccess": {
             "type": "object",
             "required": [
-                "message",
+                "data",
                 "success"
             ],
             "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "OK"
+                "data": {
+                    "description": "Búsqueda guardada",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.SavedSearch"
+                        }
+                    ]
                 },
                 "success": {
+                    "description": "Indica si la petición fue exitosa",
                     "type": "boolean",
                     "example": true
                 }
             }
         },
-        "domain.PlaceHolderResponseSuccess": {
+        "domain.SearchFilters": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "cc_max": {
+                    "type": "integer",
+                    "example": 700
+                },
+                "cc_min": {
+                    "type": "integer",
+                    "example": 250
+                },
+                "city": {
+                    "type": "string",
+                    "example": "Medellín"
+                },
+                "deal": {
+                    "type": "string",
+                    "example": "great,good"
+                },
+                "department": {
+                    "type": "string",
+                    "example": "Antioquia"
+                },
+                "docs_valid": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "extras": {
+                    "type": "string",
+                    "example": "abs,side_cases"
+                },
+                "hp_min": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "km_max": {
+                    "type": "integer",
+                    "example": 30000
+                },
+                "lat": {
+                    "type": "number",
+                    "example": 6.2442
+                },
+                "lng": {
+                    "type": "number",
+                    "example": -75.5812
+                },
+                "mode": {
+                    "type": "string",
+                    "example": "substring"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "MT-03"
+                },
+                "price_max": {
+                    "type": "integer",
+                    "example": 25000000
+                },
+                "price_min": {
+                    "type": "integer",
+                    "example": 10000000
+                },
+                "radius_km": {
+                    "type": "number",
+                    "example": 20
+                },
+                "year_max": {
+                    "type": "integer",
+                    "example": 2024
+                },
+                "year_min": {
+                    "type": "integer",
+                    "example": 2018
+                }
+            }
+        },
+        "domain.SimilarBikesResponseSuccess": {
             "type": "object",
             "required": [
                 "data",
@@ -297,56 +2976,302 @@ const docTemplate = `{
             ],
             "properties": {
                 "data": {
+                    "description": "Motos ordenadas de la más a la menos parecida",
+                    "type": "array",
+                    "items": {
+                        "type": "object"
+                    }
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                },
+                "total": {
+                    "description": "Número de motos retornadas",
+                    "type": "integer",
+                    "example": 6
+                }
+            }
+        },
+        "domain.StatsResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Estadísticas del catálogo en el rango de fechas",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.CatalogStats"
+                        }
+                    ]
+                },
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.UpdateBykeRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "brand": {
+                    "type": "string",
+                    "example": "Yamaha"
+                },
+                "city_register": {
+                    "type": "string",
+                    "example": "Envigado"
+                },
+                "cylinder": {
+                    "type": "string",
+                    "example": "889 cc"
+                },
+                "date_soat": {
+                    "type": "string",
+                    "example": "15/03/2026"
+                },
+                "date_tecnico": {
+                    "type": "string",
+                    "example": "03/2026"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Unico dueño, mantenimientos en concesionario"
+                },
+                "engine": {
+                    "type": "string",
+                    "example": "Tricilindrico 4T"
+                },
+                "extras": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     },
                     "example": [
-                        "[\"Ducati\"",
-                        "\"BMW\"]"
+                        "ABS",
+                        "maletas laterales"
                     ]
                 },
-                "success": {
+                "full_name": {
+                    "type": "string",
+                    "example": "Yamaha MT-09"
+                },
+                "horse_power": {
+                    "type": "string",
+                    "example": "117 HP @ 10000rpm"
+                },
+                "km": {
+                    "type": "integer",
+                    "example": 12000
+                },
+                "location": {
+                    "type": "string",
+                    "example": "Medellín - Antioquia"
+                },
+                "model": {
+                    "type": "string",
+                    "example": "MT-09"
+                },
+                "page_instagram": {
+                    "type": "string",
+                    "example": "motos_medellin"
+                },
+                "photos": {
+                    "type": "array",
+                    "items": {
+                        "type": "array",
+                        "items": {
+                            "type": "object"
+                        }
+                    }
+                },
+                "price": {
+                    "type": "integer",
+                    "example": 43000000
+                },
+                "ref": {
+                    "type": "string",
+                    "example": "1234"
+                },
+                "reviewed": {
                     "type": "boolean",
                     "example": true
                 },
-                "total": {
+                "torque": {
+                    "type": "string",
+                    "example": "93 Nm"
+                },
+                "url_post": {
+                    "type": "string",
+                    "example": "https://www.instagram.com/p/abc123"
+                },
+                "version": {
+                    "description": "Versión leída de la publicación, alternativa al header If-Match",
                     "type": "integer",
-                    "example": 2
+                    "example": 3
+                },
+                "weight": {
+                    "type": "string",
+                    "example": "189 kg"
+                },
+                "year_model": {
+                    "type": "integer",
+                    "example": 2021
                 }
             }
         },
-        "domain.ResponseHttpError": {
+        "domain.UpdateBykeResponseSuccess": {
             "type": "object",
             "required": [
-                "code",
-                "error",
-                "message",
+                "data",
                 "success"
             ],
             "properties": {
-                "code": {
-                    "description": "Código de error HTTP",
-                    "type": "integer",
-                    "example": 400
+                "data": {
+                    "description": "Publicación con los cambios aplicados y su nueva versión",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Bike"
+                        }
+                    ]
                 },
-                "error": {
-                    "description": "Identificador del tipo de error",
+                "success": {
+                    "description": "Indica si la petición fue exitosa",
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "domain.Valuation": {
+            "type": "object",
+            "properties": {
+                "brand": {
+                    "description": "Marca consultada",
                     "type": "string",
-                    "example": "bad_request"
+                    "example": "Yamaha"
                 },
-                "message": {
-                    "description": "Mensaje descriptivo del error",
+                "confidence": {
+                    "description": "Confianza de la estimación: high, medium o low",
                     "type": "string",
-                    "example": "error with request"
+                    "example": "medium"
+                },
+                "depreciation": {
+                    "description": "Depreciación estimada del segmento",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Depreciation"
+                        }
+                    ]
+                },
+                "estimate": {
+                    "description": "Precio estimado ajustado por año y kilometraje",
+                    "type": "integer",
+                    "example": 21500000
+                },
+                "high": {
+                    "description": "Límite superior del rango de precio justo",
+                    "type": "integer",
+                    "example": 23000000
+                },
+                "iqr": {
+                    "description": "Rango intercuartil de precio del segmento",
+                    "type": "integer",
+                    "example": 3000000
+                },
+                "km": {
+                    "description": "Kilometraje consultado, 0 si no se envió",
+                    "type": "integer",
+                    "example": 15000
+                },
+                "low": {
+                    "description": "Límite inferior del rango de precio justo",
+                    "type": "integer",
+                    "example": 20000000
+                },
+                "median": {
+                    "description": "Mediana de precio del segmento",
+                    "type": "integer",
+                    "example": 21000000
+                },
+                "model": {
+                    "description": "Modelo consultado",
+                    "type": "string",
+                    "example": "MT-03"
+                },
+                "q1": {
+                    "description": "Primer cuartil de precio del segmento",
+                    "type": "integer",
+                    "example": 19500000
+                },
+                "q3": {
+                    "description": "Tercer cuartil de precio del segmento",
+                    "type": "integer",
+                    "example": 22500000
+                },
+                "sample_size": {
+                    "description": "Cantidad de motos comparables usadas",
+                    "type": "integer",
+                    "example": 14
+                },
+                "year": {
+                    "description": "Año consultado, 0 si no se envió",
+                    "type": "integer",
+                    "example": 2021
+                }
+            }
+        },
+        "domain.ValuationResponseSuccess": {
+            "type": "object",
+            "required": [
+                "data",
+                "success"
+            ],
+            "properties": {
+                "data": {
+                    "description": "Valoración de la moto",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/domain.Valuation"
+                        }
+                    ]
                 },
                 "success": {
-                    "description": "Indica si la operación fue exitosa (en este caso false)",
+                    "description": "Indica si la petición fue exitosa",
                     "type": "boolean",
-                    "example": false
+                    "example": true
+                }
+            }
+        },
+        "domain.YearFacetCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2020
                 }
             }
         }
+    },
+    "securityDefinitions": {
+        "BearerAuth": {
+            "description": "Admin token as \"Bearer \u003ctoken\u003e\"",
+            "type": "apiKey",
+            "name": "Authorization",
+            "in": "header"
+        }
     }
 }`
 
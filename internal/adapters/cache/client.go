@@ -11,12 +11,15 @@ import (
 type CacheEntry[T any] struct {
 	value     T
 	timestamp time.Time
+	ttl       time.Duration
 	element   *list.Element
 }
 
 type CacheClient[K comparable, T any] interface {
 	Get(key K) (T, bool)
 	Set(key K, value T)
+	SetWithTTL(key K, value T, ttl time.Duration)
+	DeleteFunc(match func(key K) bool)
 	Clear()
 }
 
@@ -53,7 +56,7 @@ func (c *LRUCache[K, T]) Get(key K) (T, bool) {
 		return zero, false
 	}
 
-	if time.Since(entry.timestamp) > c.ttl {
+	if time.Since(entry.timestamp) > entry.ttl {
 		c.lruList.Remove(entry.element)
 		delete(c.entries, key)
 		var zero T
@@ -66,12 +69,18 @@ func (c *LRUCache[K, T]) Get(key K) (T, bool) {
 }
 
 func (c *LRUCache[K, T]) Set(key K, value T) {
+	c.SetWithTTL(key, value, c.ttl)
+}
+
+// SetWithTTL guarda el valor con su propio tiempo de vida
+func (c *LRUCache[K, T]) SetWithTTL(key K, value T, ttl time.Duration) {
 	c.mutext.Lock()
 	defer c.mutext.Unlock()
 
 	if entry, exists := c.entries[key]; exists {
 		entry.value = value
 		entry.timestamp = time.Now()
+		entry.ttl = ttl
 		c.entries[key] = entry
 		c.lruList.MoveToFront(entry.element)
 		return
@@ -83,7 +92,20 @@ func (c *LRUCache[K, T]) Set(key K, value T) {
 		delete(c.entries, oldest.Value.(K))
 	}
 
-	c.entries[key] = CacheEntry[T]{value: value, timestamp: time.Now(), element: c.lruList.PushFront(key)}
+	c.entries[key] = CacheEntry[T]{value: value, timestamp: time.Now(), ttl: ttl, element: c.lruList.PushFront(key)}
+}
+
+// DeleteFunc elimina las entradas cuya llave cumple la condicion
+func (c *LRUCache[K, T]) DeleteFunc(match func(key K) bool) {
+	c.mutext.Lock()
+	defer c.mutext.Unlock()
+
+	for key, entry := range c.entries {
+		if match(key) {
+			c.lruList.Remove(entry.element)
+			delete(c.entries, key)
+		}
+	}
 }
 
 func (c *LRUCache[K, T]) Clear() {
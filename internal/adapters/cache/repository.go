@@ -1,6 +1,10 @@
 package cache
 
-import "github.com/Bikes2Road/bikes-compass/internal/core/ports"
+import (
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+)
 
 type CacheRepository struct {
 	client ports.CacheClient[string, any]
@@ -18,6 +22,14 @@ func (r *CacheRepository) SetCached(key string, value any) {
 	r.client.Set(key, value)
 }
 
+func (r *CacheRepository) SetCachedWithTTL(key string, value any, ttl time.Duration) {
+	r.client.SetWithTTL(key, value, ttl)
+}
+
+func (r *CacheRepository) DeleteCachedFunc(match func(key string) bool) {
+	r.client.DeleteFunc(match)
+}
+
 func (r *CacheRepository) ClearCache() {
 	r.client.Clear()
 }
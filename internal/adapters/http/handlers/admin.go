@@ -0,0 +1,299 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"github.com/gin-gonic/gin"
+)
+
+// Rangos aceptados en las publicaciones creadas o editadas desde la administracion
+const (
+	minYearModel = 1950
+	minPrice     = 500000
+	maxPrice     = 2000000000
+	maxKm        = 500000
+)
+
+// Create Byke
+// @Summary Create Byke
+// @Description This service creates a listing with a new hash_byke, pending of review (reviewed=false) and active unless active is false. Photos need a first group with at least one photo. Spec, extras, document and location fields used by /search are derived from the payload
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Param request body domain.CreateBykeRequest true "Listing to create"
+// @Produce json
+// @Success 201 {object} domain.CreateBykeResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /admin/byke [post]
+func (h *ApiHandler) CreateBykeHandler(c *gin.Context) {
+	var bodyRequest domain.CreateBykeRequest
+
+	if err := c.ShouldBindJSON(&bodyRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if err := validateNewByke(bodyRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	byke, errResp := h.application.CreateByke.Execute(h.ctx, bodyRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusCreated, byke)
+}
+
+// validateNewByke valida los textos obligatorios y que año, precio y kilometraje esten en rangos razonables
+func validateNewByke(request domain.CreateBykeRequest) error {
+	required := []struct{ field, value string }{
+		{"brand", request.Brand},
+		{"model", request.Model},
+		{"full_name", request.FullName},
+		{"location", request.Location},
+		{"page_instagram", request.PageInstagram},
+	}
+	for _, item := range required {
+		if strings.TrimSpace(item.value) == "" {
+			return errors.New(item.field + " can not be empty")
+		}
+	}
+
+	if !text.IsValidSearch(request.Brand) {
+		return errors.New("brand can only contain letters, numbers, spaces, hyphens and dots")
+	}
+
+	if err := validatePhotos(request.Photos); err != nil {
+		return err
+	}
+
+	return validateBykeValues(&request.YearModel, &request.Price, request.Kilometers, &request.UrlPost)
+}
+
+// validatePhotos exige un primer grupo de fotos (la portada que muestra /search) con al menos una foto
+// y que todas las fotos tengan su llave en R2
+func validatePhotos(photos [][]domain.Photo) error {
+	if len(photos) == 0 || len(photos[0]) == 0 {
+		return errors.New("photos must have at least one group with at least one photo")
+	}
+
+	for _, group := range photos {
+		for _, photo := range group {
+			if strings.TrimSpace(photo.Key) == "" {
+				return errors.New("every photo must have its key")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Update Byke
+// @Summary Update Byke
+// @Description This service edits the fields sent of a listing, other fields are rejected. The version read from /byke/{hash_byke} (ETag) must be sent in If-Match or as version, and it fails with 412 if the listing changed since then. Derived spec, extras, document and location fields are recalculated and the cached detail of the listing and the cached responses built from several listings are cleared
+// @Tags Admin
+// @Security BearerAuth
+// @Accept json
+// @Param hash_byke path string true "Hash of Byke that you want edit"
+// @Param If-Match header string false "Version of the byke, required unless version is sent in the body"
+// @Param request body domain.UpdateBykeRequest true "Fields to edit"
+// @Produce json
+// @Success 200 {object} domain.UpdateBykeResponseSuccess
+// @Header 200 {string} ETag "New version of the byke"
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 412 {object} domain.ResponseHttpError
+// @Failure 428 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /admin/byke/{hash_byke} [patch]
+func (h *ApiHandler) UpdateBykeHandler(c *gin.Context) {
+	var paramRequest domain.SearchBykeRequest
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil || !hashBykePattern.MatchString(paramRequest.HashByke) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	// Only the fields of UpdateBykeRequest can be edited, any other field is rejected
+	var bodyRequest domain.UpdateBykeRequest
+	decoder := json.NewDecoder(c.Request.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&bodyRequest); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = errors.New("the body must have the fields to update")
+		}
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if err := validateBykeUpdate(bodyRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	version, errResp := requestVersion(c.GetHeader("If-Match"), bodyRequest.Version)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	byke, errResp := h.application.UpdateByke.Execute(h.ctx, paramRequest.HashByke, version, bodyRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.Header("ETag", versionETag(byke.Data.Version))
+	c.JSON(http.StatusOK, byke)
+}
+
+// Delete Byke
+// @Summary Delete Byke
+// @Description This service deletes a listing and clears its cached detail and the cached responses built from several listings
+// @Tags Admin
+// @Security BearerAuth
+// @Param hash_byke path string true "Hash of Byke that you want delete"
+// @Success 204
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /admin/byke/{hash_byke} [delete]
+func (h *ApiHandler) DeleteBykeHandler(c *gin.Context) {
+	var paramRequest domain.SearchBykeRequest
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil || !hashBykePattern.MatchString(paramRequest.HashByke) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if errResp := h.application.DeleteByke.Execute(h.ctx, paramRequest.HashByke); errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// validateBykeUpdate valida los campos enviados con las mismas reglas de la creacion
+func validateBykeUpdate(request domain.UpdateBykeRequest) error {
+	notEmpty := []struct {
+		field string
+		value *string
+	}{
+		{"brand", request.Brand},
+		{"model", request.Model},
+		{"full_name", request.FullName},
+		{"location", request.Location},
+		{"page_instagram", request.PageInstagram},
+	}
+	for _, item := range notEmpty {
+		if item.value != nil && strings.TrimSpace(*item.value) == "" {
+			return errors.New(item.field + " can not be empty")
+		}
+	}
+
+	if request.Brand != nil && !text.IsValidSearch(*request.Brand) {
+		return errors.New("brand can only contain letters, numbers, spaces, hyphens and dots")
+	}
+
+	if request.Photos != nil {
+		if err := validatePhotos(*request.Photos); err != nil {
+			return err
+		}
+	}
+
+	return validateBykeValues(request.YearModel, request.Price, request.Kilometers, request.UrlPost)
+}
+
+// requestVersion retorna la version del header If-Match ("3" o W/"3") o, si no se envia, la del body
+func requestVersion(ifMatch string, bodyVersion *int) (int, *domain.ResponseHttpError) {
+	if ifMatch = strings.TrimSpace(ifMatch); ifMatch != "" {
+		version, err := strconv.Atoi(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`))
+		if err != nil || version < 0 {
+			return 0, errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, errors.New("If-Match must be the version of the byke returned in its ETag"))
+		}
+		return version, nil
+	}
+
+	if bodyVersion == nil {
+		return 0, errorBikes.MapErrorResponse(errorBikes.ErrorVersionRequired, nil)
+	}
+
+	if *bodyVersion < 0 {
+		return 0, errorBikes.MapErrorResponse(errorBikes.ErrorInvalidByke, errors.New("version must be a positive number"))
+	}
+
+	return *bodyVersion, nil
+}
+
+// versionETag retorna la version de la moto como ETag
+func versionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// validateBykeValues valida los valores informados, los nil no se validan
+func validateBykeValues(yearModel, price, km *int, urlPost *string) error {
+	if maxYear := time.Now().Year() + 1; yearModel != nil && (*yearModel < minYearModel || *yearModel > maxYear) {
+		return fmt.Errorf("year_model must be between %d and %d", minYearModel, maxYear)
+	}
+
+	if price != nil && (*price < minPrice || *price > maxPrice) {
+		return fmt.Errorf("price must be between %d and %d", minPrice, maxPrice)
+	}
+
+	if km != nil && (*km < 0 || *km > maxKm) {
+		return fmt.Errorf("km must be between 0 and %d", maxKm)
+	}
+
+	if urlPost != nil {
+		if parsed, err := url.ParseRequestURI(*urlPost); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return errors.New("url_post must be an http or https url")
+		}
+	}
+
+	return nil
+}
+
+// Get Duplicate Clusters
+// @Summary Search Duplicate Clusters
+// @Description This service returns the groups of active listings detected as the same bike published by several pages, the canonical listing is the one shown in /search
+// @Tags Admin
+// @Security BearerAuth
+// @Produce json
+// @Success 200 {object} domain.DuplicateClustersResponseSuccess
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /admin/duplicates [get]
+func (h *ApiHandler) GetDuplicateClustersHandler(c *gin.Context) {
+	clusters, errResp := h.application.DuplicateClusters.Execute(h.ctx)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, clusters)
+}
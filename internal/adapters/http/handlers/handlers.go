@@ -2,16 +2,26 @@ package handlers
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core"
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
 	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
 	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/extras"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
 	"github.com/gin-gonic/gin"
 )
 
+// hashBykePattern valida que el hash_byke sea alfanumérico de exactamente 12 caracteres
+var hashBykePattern = regexp.MustCompile(`^[A-Za-z0-9]{12}$`)
+
 type ApiHandler struct {
 	application core.Application
 	ctx         context.Context
@@ -32,10 +42,29 @@ func NewApiHandler(application core.Application) ports.ApiHandler {
 // @Param cant query int false "cant bikes you want extract" maximum(30)
 // @Param name query string false "name of byke that you want search" example(BMW M1000RR)
 // @Param brand query string false "brand of byke that you want search" example(BMW)
+// @Param price_min query int false "minimum price of byke" minimum(0)
+// @Param price_max query int false "maximum price of byke" minimum(0)
+// @Param year_min query int false "minimum year model of byke" minimum(0)
+// @Param year_max query int false "maximum year model of byke" minimum(0)
+// @Param km_max query int false "maximum kilometers of byke" minimum(0)
+// @Param cc_min query int false "minimum cylinder of byke in cc" minimum(0)
+// @Param cc_max query int false "maximum cylinder of byke in cc" minimum(0)
+// @Param hp_min query int false "minimum horse power of byke in hp" minimum(0)
+// @Param docs_valid query bool false "only bykes with SOAT and tecnico-mecanica not expired"
+// @Param extras query string false "tags from /extras separated by commas, bykes must have all of them"
+// @Param city query string false "city name or DANE code of the municipality" example(Medellín)
+// @Param department query string false "department name or DANE code" example(Antioquia)
+// @Param lat query number false "latitude of the point for near me search" example(6.2442)
+// @Param lng query number false "longitude of the point for near me search" example(-75.5812)
+// @Param radius_km query number false "radius in km around lat and lng" minimum(1) maximum(1000)
+// @Param deal query string false "deal scores separated by commas (great, good, fair, high)" example(great,good)
+// @Param mode query string false "how name is matched, text uses the text index ranked by relevance" Enums(substring, text) default(substring)
+// @Param sort query string false "order of results, relevance only with mode text" Enums(price_asc, price_desc, newest, km_asc, year_desc, deal, relevance) default(newest)
+// @Param cursor query string false "opaque cursor returned as next_cursor, when present page is ignored"
 // @Produce json
 // @Success 200 {object} domain.GetAllResponseSuccess
+// @Header 200 {string} Link "Pagination links (first, prev, next, last)"
 // @Failure 400 {object} domain.ResponseHttpError
-// @Failure 404 {object} domain.ResponseHttpError
 // @Failure 401 {object} domain.ResponseHttpError
 // @Failure 500 {object} domain.ResponseHttpError
 // @Router /search [get]
@@ -67,41 +96,237 @@ func (h *ApiHandler) GetAllBikesHandler(c *gin.Context) {
 		return
 	}
 
-	if queryRequest.Cant > 30 {
+	if queryRequest.Cant < 0 || queryRequest.Cant > 30 {
 		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCant, nil)
 		c.JSON(errResponse.Code, errResponse)
 		return
 	}
 
-	// INSERT_YOUR_CODE
-	// Validar que Name solo contenga letras (mayúsculas, minúsculas, espacios) o esté vacío usando regex
-	if queryRequest.Name != "" {
-		matched, _ := regexp.MatchString(`^[A-Za-z0-9\s]+$`, queryRequest.Name)
-		if !matched {
-			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
-			c.JSON(errResponse.Code, errResponse)
-			return
-		}
+	if errResponse := validateSearchFilters(queryRequest); errResponse != nil {
+		c.JSON(errResponse.Code, errResponse)
+		return
 	}
 
-	if queryRequest.Brand != "" {
-		matched, _ := regexp.MatchString(`^[A-Za-z\s]+$`, queryRequest.Brand)
-		if !matched {
-			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
-			c.JSON(errResponse.Code, errResponse)
-			return
+	if queryRequest.Sort == "" {
+		queryRequest.Sort = domain.SortNewest
+		if queryRequest.IsTextSearch() {
+			queryRequest.Sort = domain.SortRelevance
 		}
 	}
 
+	if !domain.ValidSorts[queryRequest.Sort] {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSort, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	// Relevance only exists over the text index and can't be continued with a cursor
+	if queryRequest.Sort == domain.SortRelevance && (!queryRequest.IsTextSearch() || queryRequest.Cursor != "") {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSearchMode, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
 	bikes, errResp := h.application.GetAllBikes.Execute(h.ctx, queryRequest, pathRequest)
 	if errResp != nil {
 		c.JSON(errResp.Code, errResp)
 		return
 	}
 
+	if links := paginationLinks(c.Request.URL, bikes, queryRequest.Cursor != ""); links != "" {
+		c.Header("Link", links)
+	}
+
 	c.JSON(http.StatusOK, bikes)
 }
 
+// Get Facets
+// @Summary Search Facets
+// @Description This service counts active bikes by brand, location, year model and price/km ranges, it accepts the same filters as /search
+// @Tags Bikes 2 Road
+// @Param name query string false "name of byke that you want search" example(BMW M1000RR)
+// @Param brand query string false "brand of byke that you want search" example(BMW)
+// @Param price_min query int false "minimum price of byke" minimum(0)
+// @Param price_max query int false "maximum price of byke" minimum(0)
+// @Param year_min query int false "minimum year model of byke" minimum(0)
+// @Param year_max query int false "maximum year model of byke" minimum(0)
+// @Param km_max query int false "maximum kilometers of byke" minimum(0)
+// @Param cc_min query int false "minimum cylinder of byke in cc" minimum(0)
+// @Param cc_max query int false "maximum cylinder of byke in cc" minimum(0)
+// @Param hp_min query int false "minimum horse power of byke in hp" minimum(0)
+// @Param docs_valid query bool false "only bykes with SOAT and tecnico-mecanica not expired"
+// @Param extras query string false "tags from /extras separated by commas, bykes must have all of them"
+// @Param city query string false "city name or DANE code of the municipality" example(Medellín)
+// @Param department query string false "department name or DANE code" example(Antioquia)
+// @Param lat query number false "latitude of the point for near me search" example(6.2442)
+// @Param lng query number false "longitude of the point for near me search" example(-75.5812)
+// @Param radius_km query number false "radius in km around lat and lng" minimum(1) maximum(1000)
+// @Param deal query string false "deal scores separated by commas (great, good, fair, high)" example(great,good)
+// @Param mode query string false "how name is matched, text uses the text index" Enums(substring, text) default(substring)
+// @Produce json
+// @Success 200 {object} domain.FacetsResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /facets [get]
+func (h *ApiHandler) GetFacetsHandler(c *gin.Context) {
+	var queryRequest domain.GetAllBikesRequest
+
+	pathRequest := c.Request.RequestURI
+
+	err := c.BindQuery(&queryRequest)
+	if err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if errResponse := validateSearchFilters(queryRequest); errResponse != nil {
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	facets, errResp := h.application.GetFacets.Execute(h.ctx, queryRequest, pathRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, facets)
+}
+
+// Get Locations
+// @Summary Search Locations
+// @Description This service lists the municipalities of the DANE catalog that have active bikes, with the number of bikes in each one
+// @Tags Bikes 2 Road
+// @Produce json
+// @Success 200 {object} domain.LocationsResponseSuccess
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /locations [get]
+func (h *ApiHandler) GetLocationsHandler(c *gin.Context) {
+	locations, errResp := h.application.GetLocations.Execute(h.ctx)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, locations)
+}
+
+// Get Extra Tags
+// @Summary Search Extra Tags
+// @Description This service lists the equipment tags that can be used in the extras filter of /search, with the number of active bikes that have each one
+// @Tags Bikes 2 Road
+// @Produce json
+// @Success 200 {object} domain.ExtraTagsResponseSuccess
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /extras [get]
+func (h *ApiHandler) GetExtraTagsHandler(c *gin.Context) {
+	tags, errResp := h.application.GetExtraTags.Execute(h.ctx)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// Get Brands
+// @Summary Search Brands
+// @Description This service lists the brands with active bikes, with the number of bikes and the range of model years of each one
+// @Tags Bikes 2 Road
+// @Produce json
+// @Success 200 {object} domain.BrandsResponseSuccess
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /brands [get]
+func (h *ApiHandler) GetBrandsHandler(c *gin.Context) {
+	brands, errResp := h.application.GetBrands.Execute(h.ctx)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, brands)
+}
+
+// Get Brand Models
+// @Summary Search Models of a Brand
+// @Description This service lists the models of a brand with active bikes, with the number of bikes and the range of model years of each one. The brand is matched without case or accents
+// @Tags Bikes 2 Road
+// @Param brand path string true "Name of the brand"
+// @Produce json
+// @Success 200 {object} domain.ModelsResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /brands/{brand}/models [get]
+func (h *ApiHandler) GetBrandModelsHandler(c *gin.Context) {
+	var paramRequest domain.BrandModelsRequest
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !text.IsValidSearch(paramRequest.Brand) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	models, errResp := h.application.GetBrandModels.Execute(h.ctx, paramRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, models)
+}
+
+// Get Stats
+// @Summary Search Catalog Stats
+// @Description This service returns the new reviewed listings per day and ISO week (by date_publish, or date_found when missing), the average and median price by brand and year, the km distribution and the active and inactive listings of a date range
+// @Tags Bikes 2 Road
+// @Param from query string false "first day of the range (YYYY-MM-DD), 30 days before to by default"
+// @Param to query string false "last day of the range (YYYY-MM-DD), today by default"
+// @Param brand query string false "brand of the listings"
+// @Produce json
+// @Success 200 {object} domain.StatsResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /stats [get]
+func (h *ApiHandler) GetStatsHandler(c *gin.Context) {
+	var paramRequest domain.StatsRequest
+
+	if err := c.ShouldBindQuery(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if paramRequest.Brand != "" && !text.IsValidSearch(paramRequest.Brand) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	pathRequest := c.Request.RequestURI
+
+	stats, errResp := h.application.GetStats.Execute(h.ctx, paramRequest, pathRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
+
 // Get Byke
 // @Summary Search Byke by Hash
 // @Description This service extract all data from a Byke by Hash_Byke
@@ -109,6 +334,7 @@ func (h *ApiHandler) GetAllBikesHandler(c *gin.Context) {
 // @Param hash_byke path string true "Hash of Byke that you want extract"
 // @Produce json
 // @Success 200 {object} domain.GetBykeResponseSuccess
+// @Header 200 {string} ETag "Version of the byke, send it in If-Match to edit it"
 // @Failure 400 {object} domain.ResponseHttpError
 // @Failure 404 {object} domain.ResponseHttpError
 // @Failure 401 {object} domain.ResponseHttpError
@@ -117,35 +343,276 @@ func (h *ApiHandler) GetAllBikesHandler(c *gin.Context) {
 func (h *ApiHandler) GetBykeHandler(c *gin.Context) {
 	var paramRequest domain.SearchBykeRequest
 
-	pathRequest := c.Request.RequestURI
-
 	if err := c.ShouldBindUri(&paramRequest); err != nil {
 		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParams, err)
 		c.JSON(errResponse.Code, errResponse)
 		return
 	}
 
-	// INSERT_YOUR_CODE
 	// Validar que HashByke sea un string alfanumérico de exactamente 12 dígitos usando regex
-	matched, _ := regexp.MatchString(`^[A-Za-z0-9]{12}$`, paramRequest.HashByke)
-	if !matched {
+	if !hashBykePattern.MatchString(paramRequest.HashByke) {
 		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
 		c.JSON(errResponse.Code, errResponse)
 		return
 	}
 
-	byke, errResp := h.application.GetByke.Execute(h.ctx, paramRequest, pathRequest)
+	byke, errResp := h.application.GetByke.Execute(h.ctx, paramRequest)
 	if errResp != nil {
 		c.JSON(errResp.Code, errResp)
 		return
 	}
 
+	c.Header("ETag", versionETag(byke.Data.Version))
 	c.JSON(http.StatusOK, byke)
 }
 
+// Get Similar Bikes
+// @Summary Search Similar Bikes
+// @Description This service returns the active bikes most similar to a Byke, weighted by brand, model, cylinder class, year, km and price
+// @Tags Bikes 2 Road
+// @Param hash_byke path string true "Hash of Byke that you want compare"
+// @Param cant query int false "cant similar bikes you want extract" maximum(30) default(6)
+// @Produce json
+// @Success 200 {object} domain.SimilarBikesResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /byke/{hash_byke}/similar [get]
+func (h *ApiHandler) GetSimilarBikesHandler(c *gin.Context) {
+	var paramRequest domain.SimilarBikesRequest
+
+	pathRequest := c.Request.RequestURI
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if err := c.BindQuery(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !hashBykePattern.MatchString(paramRequest.HashByke) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if paramRequest.Cant == 0 {
+		paramRequest.Cant = 6
+	}
+
+	if paramRequest.Cant < 0 || paramRequest.Cant > 30 {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCant, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	bikes, errResp := h.application.GetSimilar.Execute(h.ctx, paramRequest, pathRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, bikes)
+}
+
+// Get Price History
+// @Summary Search Price History of Byke
+// @Description This service returns the price changes of a Byke from the oldest to the newest and its current price
+// @Tags Bikes 2 Road
+// @Param hash_byke path string true "Hash of Byke that you want extract"
+// @Produce json
+// @Success 200 {object} domain.PriceHistoryResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /byke/{hash_byke}/price-history [get]
+func (h *ApiHandler) GetPriceHistoryHandler(c *gin.Context) {
+	var paramRequest domain.SearchBykeRequest
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !hashBykePattern.MatchString(paramRequest.HashByke) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	history, errResp := h.application.PriceHistory.Execute(h.ctx, paramRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, history)
+}
+
+// Get Bikes Batch
+// @Summary Search Bykes by Hashes
+// @Description This service extract all data from up to 50 Bykes by Hash_Byke in one request, hashes not found are returned in missing
+// @Tags Bikes 2 Road
+// @Accept json
+// @Param request body domain.BatchBikesRequest true "Hashes of Bykes that you want extract"
+// @Produce json
+// @Success 200 {object} domain.BatchBikesResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /byke/batch [post]
+func (h *ApiHandler) GetBikesBatchHandler(c *gin.Context) {
+	var bodyRequest domain.BatchBikesRequest
+
+	if err := c.ShouldBindJSON(&bodyRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorBadRequest, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	// Repeated hashes are searched once
+	hashes := []string{}
+	seen := map[string]bool{}
+	for _, hash := range bodyRequest.Hashes {
+		if !hashBykePattern.MatchString(hash) {
+			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidBatch, nil)
+			c.JSON(errResponse.Code, errResponse)
+			return
+		}
+		if !seen[hash] {
+			seen[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+
+	if len(hashes) == 0 || len(hashes) > 50 {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidBatch, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+	bodyRequest.Hashes = hashes
+
+	bikes, errResp := h.application.GetBatch.Execute(h.ctx, bodyRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, bikes)
+}
+
+// Get Valuation
+// @Summary Estimate Market Value
+// @Description This service estimates the fair price of a Byke from comparable reviewed listings (active and sold): segment median and IQR adjusted by year and km depreciation
+// @Tags Bikes 2 Road
+// @Param brand query string true "brand of byke" example(Yamaha)
+// @Param model query string true "model of byke" example(MT-03)
+// @Param year query int false "year model of byke" minimum(0)
+// @Param km query int false "kilometers of byke" minimum(0)
+// @Produce json
+// @Success 200 {object} domain.ValuationResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /valuation [get]
+func (h *ApiHandler) GetValuationHandler(c *gin.Context) {
+	var queryRequest domain.ValuationRequest
+
+	pathRequest := c.Request.RequestURI
+
+	if err := c.ShouldBindQuery(&queryRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidValuation, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !text.IsValidSearch(queryRequest.Brand) || !text.IsValidSearch(queryRequest.Model) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if queryRequest.Year < 0 || queryRequest.Km < 0 {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidValuation, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	valuation, errResp := h.application.Valuation.Execute(h.ctx, queryRequest, pathRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, valuation)
+}
+
+// Compare Bikes
+// @Summary Compare Bikes
+// @Description This service compares 2 to 4 Bykes side by side, with aligned spec rows and the best value of each row marked
+// @Tags Bikes 2 Road
+// @Param hash query []string true "Hash of each Byke that you want compare" collectionFormat(multi)
+// @Produce json
+// @Success 200 {object} domain.CompareResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /compare [get]
+func (h *ApiHandler) CompareBikesHandler(c *gin.Context) {
+	var queryRequest domain.CompareBikesRequest
+
+	pathRequest := c.Request.RequestURI
+
+	if err := c.BindQuery(&queryRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	// Repeated hashes are compared once
+	hashes := []string{}
+	seen := map[string]bool{}
+	for _, hash := range queryRequest.Hashes {
+		if !hashBykePattern.MatchString(hash) {
+			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCompare, nil)
+			c.JSON(errResponse.Code, errResponse)
+			return
+		}
+		if !seen[hash] {
+			seen[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+
+	if len(hashes) < 2 || len(hashes) > 4 {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCompare, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+	queryRequest.Hashes = hashes
+
+	comparison, errResp := h.application.CompareBikes.Execute(h.ctx, queryRequest, pathRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, comparison)
+}
+
 // Placeholder
 // @Summary Search Byke by Hash
-// @Description This service extract a list of name bikes from a Byke by name
+// @Description This service suggests brands, models and full names for a text, tolerating typos and ranked by number of listings
 // @Tags Bikes 2 Road
 // @Param name query string false "name of byke that you want search" example(Yamaha)
 // @Produce json
@@ -165,13 +632,10 @@ func (h *ApiHandler) PlaceHolderHandler(c *gin.Context) {
 		return
 	}
 
-	if queryRequest.NameByke != "" {
-		matched, _ := regexp.MatchString(`^[A-Za-z0-9\s]+$`, queryRequest.NameByke)
-		if !matched {
-			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
-			c.JSON(errResponse.Code, errResponse)
-			return
-		}
+	if queryRequest.NameByke != "" && !text.IsValidSearch(queryRequest.NameByke) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
 	}
 
 	bikes, errResp := h.application.PlaceHolder.Execute(h.ctx, queryRequest)
@@ -197,3 +661,105 @@ func (h *ApiHandler) HealthHandler(c *gin.Context) {
 		Message: "OK",
 	})
 }
+
+// paginationLinks arma el header Link (RFC 8288) con las paginas first, prev, next y last.
+// En modo cursor solo se puede avanzar, por lo que se entrega first y next.
+// Sin resultados first y last apuntan a la pagina 1
+func paginationLinks(requestUrl *url.URL, response *domain.GetAllResponseSuccess, cursorMode bool) string {
+	lastPage := max(response.TotalPages, 1)
+
+	linkTo := func(rel string, params map[string]string) string {
+		query := requestUrl.Query()
+		query.Del("page")
+		query.Del("cursor")
+		for key, value := range params {
+			query.Set(key, value)
+		}
+		return fmt.Sprintf("<%s?%s>; rel=\"%s\"", requestUrl.Path, query.Encode(), rel)
+	}
+
+	pageLink := func(targetPage int64, rel string) string {
+		return linkTo(rel, map[string]string{"page": strconv.FormatInt(targetPage, 10)})
+	}
+
+	if cursorMode {
+		links := []string{pageLink(1, "first")}
+		if response.NextCursor != "" {
+			links = append(links, linkTo("next", map[string]string{"cursor": response.NextCursor}))
+		}
+		return strings.Join(links, ", ")
+	}
+
+	links := []string{pageLink(1, "first")}
+	if response.Page > 1 {
+		links = append(links, pageLink(min(response.Page-1, lastPage), "prev"))
+	}
+	if response.HasNext {
+		links = append(links, pageLink(response.Page+1, "next"))
+	}
+	links = append(links, pageLink(lastPage, "last"))
+
+	return strings.Join(links, ", ")
+}
+
+// validateSearchFilters valida los filtros compartidos por /search y /facets
+func validateSearchFilters(request domain.GetAllBikesRequest) *domain.ResponseHttpError {
+	// Validar que Name y Brand solo contengan letras (con tildes), numeros, espacios, guiones y puntos
+	if request.Name != "" && !text.IsValidSearch(request.Name) {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+	}
+
+	if request.Brand != "" && !text.IsValidSearch(request.Brand) {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStringBike, nil)
+	}
+
+	// Validar rangos numericos de precio, año y kilometraje
+	if request.PriceMin < 0 || request.PriceMax < 0 || (request.PriceMax > 0 && request.PriceMin > request.PriceMax) {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPriceRange, nil)
+	}
+
+	if request.YearMin < 0 || request.YearMax < 0 || (request.YearMax > 0 && request.YearMin > request.YearMax) {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidYearRange, nil)
+	}
+
+	if request.KmMax < 0 {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidKm, nil)
+	}
+
+	if request.CcMin < 0 || request.CcMax < 0 || request.HpMin < 0 || (request.CcMax > 0 && request.CcMin > request.CcMax) {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSpecRange, nil)
+	}
+
+	if request.Mode != "" && request.Mode != domain.SearchModeSubstring && request.Mode != domain.SearchModeText {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSearchMode, nil)
+	}
+
+	if request.Lat != nil || request.Lng != nil || request.RadiusKm != nil {
+		if !request.IsGeoSearch() || *request.Lat < -90 || *request.Lat > 90 || *request.Lng < -180 || *request.Lng > 180 || *request.RadiusKm < 1 || *request.RadiusKm > 1000 {
+			return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidGeo, nil)
+		}
+	}
+
+	for _, deal := range request.Deals() {
+		if !domain.ValidDeals[deal] {
+			return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidDeal, nil)
+		}
+	}
+
+	for _, tag := range request.ExtraTags() {
+		if !extras.IsValid(tag) {
+			return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidExtras, nil)
+		}
+	}
+
+	// City and department must exist in the location catalog
+	if request.City != "" && len(location.FindMunicipalities(request.City)) == 0 {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidLocation, nil)
+	}
+
+	if request.Department != "" && location.FindDepartment(request.Department) == nil {
+		return errorBikes.MapErrorResponse(errorBikes.ErrorInvalidLocation, nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	// ownerIDPattern valida el id del dueño de una busqueda guardada
+	ownerIDPattern = regexp.MustCompile(`^[A-Za-z0-9_\-]{1,64}$`)
+	// savedSearchIDPattern valida el id de una busqueda guardada o de una coincidencia (ObjectID en hexadecimal)
+	savedSearchIDPattern = regexp.MustCompile(`^[a-f0-9]{24}$`)
+)
+
+// Create Saved Search
+// @Summary Save Search
+// @Description This service saves the filters of /search for an owner, new active bikes that match them are recorded as matches
+// @Tags Saved Searches
+// @Accept json
+// @Param request body domain.CreateSavedSearchRequest true "Owner and filters of the search"
+// @Produce json
+// @Success 201 {object} domain.SavedSearchResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /saved-searches [post]
+func (h *ApiHandler) CreateSavedSearchHandler(c *gin.Context) {
+	var bodyRequest domain.CreateSavedSearchRequest
+
+	if err := c.ShouldBindJSON(&bodyRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorBadRequest, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !ownerIDPattern.MatchString(bodyRequest.OwnerID) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSavedSearch, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if errResponse := validateSearchFilters(bodyRequest.Filters.ToRequest()); errResponse != nil {
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	search, errResp := h.application.CreateSavedSearch.Execute(h.ctx, bodyRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusCreated, search)
+}
+
+// Get Saved Search Matches
+// @Summary Search Saved Search Matches
+// @Description This service returns the bikes that matched a saved search of the owner, send the id of the last match as after to poll for new ones
+// @Tags Saved Searches
+// @Param id path string true "Id of the saved search"
+// @Param owner_id query string true "Owner of the saved search"
+// @Param after query string false "id of the last match received, only matches after it are returned"
+// @Param cant query int false "cant matches you want extract" maximum(30) default(30)
+// @Produce json
+// @Success 200 {object} domain.SavedSearchMatchesResponseSuccess
+// @Failure 400 {object} domain.ResponseHttpError
+// @Failure 404 {object} domain.ResponseHttpError
+// @Failure 401 {object} domain.ResponseHttpError
+// @Failure 500 {object} domain.ResponseHttpError
+// @Router /saved-searches/{id}/matches [get]
+func (h *ApiHandler) GetSavedSearchMatchesHandler(c *gin.Context) {
+	var paramRequest domain.SavedSearchMatchesRequest
+
+	if err := c.ShouldBindUri(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if err := c.BindQuery(&paramRequest); err != nil {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, err)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !savedSearchIDPattern.MatchString(paramRequest.ID) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidPathParam, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if !ownerIDPattern.MatchString(paramRequest.OwnerID) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidSavedSearch, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if paramRequest.After != "" && !savedSearchIDPattern.MatchString(paramRequest.After) {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, errors.New("after must be the id of a saved search match"))
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	if paramRequest.Cant == 0 {
+		paramRequest.Cant = 30
+	}
+
+	if paramRequest.Cant < 0 || paramRequest.Cant > 30 {
+		errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCant, nil)
+		c.JSON(errResponse.Code, errResponse)
+		return
+	}
+
+	matches, errResp := h.application.GetSavedSearchMatches.Execute(h.ctx, paramRequest)
+	if errResp != nil {
+		c.JSON(errResp.Code, errResp)
+		return
+	}
+
+	c.JSON(http.StatusOK, matches)
+}
@@ -0,0 +1,24 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuth protege las rutas de administracion con el token enviado como "Authorization: Bearer <token>".
+// Sin token configurado todas las peticiones se rechazan
+func AdminAuth(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		bearer, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if token == "" || !found || subtle.ConstantTimeCompare([]byte(bearer), []byte(token)) != 1 {
+			errResponse := errorBikes.MapErrorResponse(errorBikes.ErrorUnauthorized, nil)
+			c.AbortWithStatusJSON(errResponse.Code, errResponse)
+			return
+		}
+
+		c.Next()
+	}
+}
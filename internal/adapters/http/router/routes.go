@@ -13,11 +13,12 @@ import (
 )
 
 type Router struct {
-	handlers ports.ApiHandler
+	handlers   ports.ApiHandler
+	adminToken string
 }
 
-func NewRouter(handlers ports.ApiHandler) ports.Router {
-	return &Router{handlers: handlers}
+func NewRouter(handlers ports.ApiHandler, adminToken string) ports.Router {
+	return &Router{handlers: handlers, adminToken: adminToken}
 }
 
 func (r *Router) SetUp(isDevelopment bool) *gin.Engine {
@@ -33,8 +34,9 @@ func (r *Router) SetUp(isDevelopment bool) *gin.Engine {
 	router.Use(middleware.Logger())
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:5173"},
-		AllowMethods:     []string{"GET", "POST", "PATCH", "PUT"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept"},
+		AllowMethods:     []string{"GET", "POST", "PATCH", "PUT", "DELETE"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", "If-Match"},
+		ExposeHeaders:    []string{"ETag"},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -45,8 +47,28 @@ func (r *Router) SetUp(isDevelopment bool) *gin.Engine {
 	bikesRouter.GET("/health", r.handlers.HealthHandler)
 
 	bikesRouter.GET("/byke/:hash_byke", r.handlers.GetBykeHandler)
+	bikesRouter.GET("/byke/:hash_byke/similar", r.handlers.GetSimilarBikesHandler)
+	bikesRouter.GET("/byke/:hash_byke/price-history", r.handlers.GetPriceHistoryHandler)
+	bikesRouter.POST("/byke/batch", r.handlers.GetBikesBatchHandler)
 	bikesRouter.GET("/search", r.handlers.GetAllBikesHandler)
+	bikesRouter.GET("/compare", r.handlers.CompareBikesHandler)
+	bikesRouter.GET("/valuation", r.handlers.GetValuationHandler)
 	bikesRouter.GET("/placeholder", r.handlers.PlaceHolderHandler)
+	bikesRouter.GET("/facets", r.handlers.GetFacetsHandler)
+	bikesRouter.GET("/locations", r.handlers.GetLocationsHandler)
+	bikesRouter.GET("/extras", r.handlers.GetExtraTagsHandler)
+	bikesRouter.GET("/brands", r.handlers.GetBrandsHandler)
+	bikesRouter.GET("/brands/:brand/models", r.handlers.GetBrandModelsHandler)
+	bikesRouter.GET("/stats", r.handlers.GetStatsHandler)
+
+	bikesRouter.POST("/saved-searches", r.handlers.CreateSavedSearchHandler)
+	bikesRouter.GET("/saved-searches/:id/matches", r.handlers.GetSavedSearchMatchesHandler)
+
+	adminRouter := bikesRouter.Group("/admin", middleware.AdminAuth(r.adminToken))
+	adminRouter.GET("/duplicates", r.handlers.GetDuplicateClustersHandler)
+	adminRouter.POST("/byke", r.handlers.CreateBykeHandler)
+	adminRouter.PATCH("/byke/:hash_byke", r.handlers.UpdateBykeHandler)
+	adminRouter.DELETE("/byke/:hash_byke", r.handlers.DeleteBykeHandler)
 
 	bikesRouter.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	bikesRouter.GET("/metrics", gin.WrapH(promhttp.Handler()))
@@ -90,9 +90,9 @@ func (c *NewClientMongo) InsertOne(ctx context.Context, collectionName string, d
 }
 
 // InsertMany inserta múltiples documentos
-func (c *NewClientMongo) InsertMany(ctx context.Context, collectionName string, documents []interface{}) (*mongo.InsertManyResult, error) {
+func (c *NewClientMongo) InsertMany(ctx context.Context, collectionName string, documents []interface{}, opts ...options.Lister[options.InsertManyOptions]) (*mongo.InsertManyResult, error) {
 	collection := c.GetCollection(collectionName)
-	return collection.InsertMany(ctx, documents)
+	return collection.InsertMany(ctx, documents, opts...)
 }
 
 // UpdateOne actualiza un documento
@@ -125,8 +125,14 @@ func (c *NewClientMongo) CountDocuments(ctx context.Context, collectionName stri
 	return collection.CountDocuments(ctx, filter, opts...)
 }
 
+// Aggregate ejecuta un pipeline de agregación
+func (c *NewClientMongo) Aggregate(ctx context.Context, collectionName string, pipeline interface{}, opts ...options.Lister[options.AggregateOptions]) (*mongo.Cursor, error) {
+	collection := c.GetCollection(collectionName)
+	return collection.Aggregate(ctx, pipeline, opts...)
+}
+
 // FindOneAndUpdate encuentra y actualiza un documento
-func (c *NewClientMongo) FindOneAndUpdate(ctx context.Context, collectionName string, filter bson.M, update bson.M, opts ...options.Lister[options.FindOneAndUpdateOptions]) *mongo.SingleResult {
+func (c *NewClientMongo) FindOneAndUpdate(ctx context.Context, collectionName string, filter bson.M, update interface{}, opts ...options.Lister[options.FindOneAndUpdateOptions]) *mongo.SingleResult {
 	collection := c.GetCollection(collectionName)
 	return collection.FindOneAndUpdate(ctx, filter, update, opts...)
 }
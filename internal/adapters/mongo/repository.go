@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
 	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
@@ -15,16 +16,18 @@ import (
 // BikeRepository implementa el repositorio de bikes usando MongoDB
 // Utiliza inyección de dependencias mediante la interfaz MongoClient
 type MongoRepository struct {
-	client         ports.MongoClient
-	collectionName string
+	client                 ports.MongoClient
+	collectionName         string
+	priceHistoryCollection string
 }
 
 // NewBikeRepository crea una nueva instancia del repositorio de bikes
 // Recibe el cliente MongoDB mediante inyección de dependencias
-func NewMongoRepository(client ports.MongoClient, collectionName string) ports.MongoRepository {
+func NewMongoRepository(client ports.MongoClient, collectionName, priceHistoryCollection string) ports.MongoRepository {
 	return &MongoRepository{
-		client:         client,
-		collectionName: collectionName,
+		client:                 client,
+		collectionName:         collectionName,
+		priceHistoryCollection: priceHistoryCollection,
 	}
 }
 
@@ -46,6 +49,40 @@ func (r *MongoRepository) FindByHash(ctx context.Context, filter bson.M, opts ..
 	return &byke, nil
 }
 
+// FindByHashes busca el detalle de las bikes que coincidan con el filtro, sin fallar si no hay resultados
+func (r *MongoRepository) FindByHashes(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.FullBykeResponse, *errorBikes.WrapperError) {
+	cursor, err := r.client.Find(ctx, r.collectionName, filter, opts...)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoFindAll, err)
+	}
+	defer cursor.Close(ctx)
+
+	bikes := []*domain.FullBykeResponse{}
+	if err := cursor.All(ctx, &bikes); err != nil {
+		newError := fmt.Errorf("failed to decode bike: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return bikes, nil
+}
+
+// FindBikes busca las bikes completas que coincidan con el filtro
+func (r *MongoRepository) FindBikes(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.Bike, *errorBikes.WrapperError) {
+	cursor, err := r.client.Find(ctx, r.collectionName, filter, opts...)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoFindAll, err)
+	}
+	defer cursor.Close(ctx)
+
+	bikes := []*domain.Bike{}
+	if err := cursor.All(ctx, &bikes); err != nil {
+		newError := fmt.Errorf("failed to decode bike: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return bikes, nil
+}
+
 // FindAll busca todas las bikes que coincidan con el filtro
 func (r *MongoRepository) FindAll(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.BykeReponse, *errorBikes.WrapperError) {
 	cursor, err := r.client.Find(ctx, r.collectionName, filter, opts...)
@@ -56,26 +93,37 @@ func (r *MongoRepository) FindAll(ctx context.Context, filter bson.M, opts ...op
 	if cursor == nil {
 		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, nil)
 	}
-	if cursor.RemainingBatchLength() == 0 {
-		return nil, errorBikes.MapError(errorBikes.ErrorBikesNotFound, nil)
-	}
 	defer cursor.Close(ctx)
 
-	var bikes []*domain.BykeReponse
+	// An empty page is a valid result, the service answers it with total 0
+	bikes := []*domain.BykeReponse{}
 	if err := cursor.All(ctx, &bikes); err != nil {
 		newError := fmt.Errorf("failed to decode bike: %w", err)
 		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
 	}
 
 	for i, byke := range bikes {
-		bikes[i].Photos = byke.Photos[:1]
+		if len(byke.Photos) > 1 {
+			bikes[i].Photos = byke.Photos[:1]
+		}
 	}
 
 	return bikes, nil
 }
 
-// FindAll busca todas las bikes que coincidan con el filtro
-func (r *MongoRepository) FindNames(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]string, *errorBikes.WrapperError) {
+// Count cuenta las bikes que coincidan con el filtro
+func (r *MongoRepository) Count(ctx context.Context, filter bson.M) (int64, *errorBikes.WrapperError) {
+	total, err := r.client.CountDocuments(ctx, r.collectionName, filter)
+	if err != nil {
+		newError := fmt.Errorf("failed to count bikes: %w", err)
+		return 0, errorBikes.MapError(errorBikes.ErrorMongoCount, newError)
+	}
+
+	return total, nil
+}
+
+// FindNames busca los nombres, marcas y modelos de las motos que coincidan con el filtro
+func (r *MongoRepository) FindNames(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.BykeName, *errorBikes.WrapperError) {
 	cursor, err := r.client.Find(ctx, r.collectionName, filter, opts...)
 	if err != nil {
 		//return nil, fmt.Errorf("failed to find bikes: %w", err)
@@ -89,25 +137,154 @@ func (r *MongoRepository) FindNames(ctx context.Context, filter bson.M, opts ...
 	}
 	defer cursor.Close(ctx)
 
-	var bikes []*domain.BykeName
-	if err := cursor.All(ctx, &bikes); err != nil {
+	var names []*domain.BykeName
+	if err := cursor.All(ctx, &names); err != nil {
 		newError := fmt.Errorf("failed to decode bike: %w", err)
 		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
 	}
 
-	names := make([]string, len(bikes))
-	for i, bike := range bikes {
-		if bike != nil {
-			names[i] = bike.FullName
-		}
+	return names, nil
+}
+
+// FindFacets ejecuta el pipeline de agregación con $facet y retorna los conteos por categoría
+func (r *MongoRepository) FindFacets(ctx context.Context, pipeline mongo.Pipeline) (*domain.BikeFacets, *errorBikes.WrapperError) {
+	cursor, err := r.client.Aggregate(ctx, r.collectionName, pipeline)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoAggregate, err)
 	}
+	defer cursor.Close(ctx)
 
-	return names, nil
+	var facets []*domain.BikeFacets
+	if err := cursor.All(ctx, &facets); err != nil {
+		newError := fmt.Errorf("failed to decode facets: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	if len(facets) == 0 {
+		return &domain.BikeFacets{}, nil
+	}
+
+	return facets[0], nil
+}
+
+// CountBy cuenta las bikes que coincidan con el filtro agrupadas por el valor de un campo
+func (r *MongoRepository) CountBy(ctx context.Context, filter bson.M, field string) ([]domain.FacetCount, *errorBikes.WrapperError) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		// Array fields are counted per item, other fields are left as they are
+		{{Key: "$unwind", Value: bson.D{{Key: "path", Value: "$" + field}, {Key: "preserveNullAndEmptyArrays", Value: true}}}},
+		{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$" + field}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := r.client.Aggregate(ctx, r.collectionName, pipeline)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoAggregate, err)
+	}
+	defer cursor.Close(ctx)
+
+	var counts []domain.FacetCount
+	if err := cursor.All(ctx, &counts); err != nil {
+		newError := fmt.Errorf("failed to decode counts by %s: %w", field, err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return counts, nil
+}
+
+// FindCatalog agrupa las bikes que coincidan con el filtro por el valor de un campo (sin importar mayúsculas),
+// con la cantidad de bikes y el rango de años de cada valor
+func (r *MongoRepository) FindCatalog(ctx context.Context, filter bson.M, field string) ([]domain.CatalogEntry, *errorBikes.WrapperError) {
+	value := bson.D{{Key: "$trim", Value: bson.D{{Key: "input", Value: "$" + field}}}}
+	// Bikes without year are left out of the range, $min and $max ignore null
+	year := bson.D{{Key: "$cond", Value: bson.A{bson.D{{Key: "$gt", Value: bson.A{"$year_model", 0}}}, "$year_model", nil}}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: bson.D{{Key: "$toLower", Value: value}}},
+			{Key: "name", Value: bson.D{{Key: "$first", Value: value}}},
+			{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}},
+			{Key: "year_min", Value: bson.D{{Key: "$min", Value: year}}},
+			{Key: "year_max", Value: bson.D{{Key: "$max", Value: year}}},
+		}}},
+		{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$ne", Value: ""}}}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+
+	cursor, err := r.client.Aggregate(ctx, r.collectionName, pipeline)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoAggregate, err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []domain.CatalogEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		newError := fmt.Errorf("failed to decode catalog by %s: %w", field, err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return entries, nil
 }
 
-// Insert inserta una nueva bike en la colección
+// EnsureIndexes crea los índices que necesitan las búsquedas si no existen
+func (r *MongoRepository) EnsureIndexes(ctx context.Context) *errorBikes.WrapperError {
+	textIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "full_name", Value: "text"},
+			{Key: "brand", Value: "text"},
+			{Key: "model", Value: "text"},
+			{Key: "description", Value: "text"},
+		},
+		Options: options.Index().
+			SetName("bikes_text_search").
+			SetDefaultLanguage("spanish").
+			SetWeights(bson.D{
+				{Key: "full_name", Value: 10},
+				{Key: "brand", Value: 5},
+				{Key: "model", Value: 5},
+				{Key: "description", Value: 1},
+			}),
+	}
+
+	geoIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "geo", Value: "2dsphere"}},
+		Options: options.Index().SetName("bikes_geo"),
+	}
+
+	// The unique index is what guarantees that a generated hash_byke is not repeated
+	hashIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "hash_byke", Value: 1}},
+		Options: options.Index().SetName("bikes_hash_byke").SetUnique(true),
+	}
+
+	_, err := r.client.GetCollection(r.collectionName).Indexes().CreateMany(ctx, []mongo.IndexModel{textIndex, geoIndex, hashIndex})
+	if err != nil {
+		newError := fmt.Errorf("failed to create indexes: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoIndex, newError)
+	}
+
+	priceHistoryIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "hash_byke", Value: 1}, {Key: "changed_at", Value: 1}},
+		Options: options.Index().SetName("price_history_byke"),
+	}
+
+	if _, err := r.client.GetCollection(r.priceHistoryCollection).Indexes().CreateOne(ctx, priceHistoryIndex); err != nil {
+		newError := fmt.Errorf("failed to create price history index: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoIndex, newError)
+	}
+
+	return nil
+}
+
+// Insert inserta una nueva bike en la colección.
+// Si ya existe una bike con el mismo hash_byke retorna ErrorDuplicateByke
 func (r *MongoRepository) Insert(ctx context.Context, bike *domain.Bike) *errorBikes.WrapperError {
 	result, err := r.client.InsertOne(ctx, r.collectionName, bike)
+	if mongo.IsDuplicateKeyError(err) {
+		newError := fmt.Errorf("byke with hash %s already exists: %w", bike.HashByke, err)
+		return errorBikes.MapError(errorBikes.ErrorDuplicateByke, newError)
+	}
 	if err != nil {
 		newError := fmt.Errorf("failed to insert bike: %w", err)
 		return errorBikes.MapError(errorBikes.ErrorBadRequest, newError)
@@ -118,25 +295,170 @@ func (r *MongoRepository) Insert(ctx context.Context, bike *domain.Bike) *errorB
 	return nil
 }
 
-// UpdateByHash actualiza una bike por su hash
+// UpdateMany actualiza todas las bikes que coincidan con el filtro
+func (r *MongoRepository) UpdateMany(ctx context.Context, filter bson.M, update bson.M) *errorBikes.WrapperError {
+	_, err := r.client.UpdateMany(ctx, r.collectionName, filter, bson.M{"$set": update})
+	if err != nil {
+		newError := fmt.Errorf("failed to update bikes: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoUpdate, newError)
+	}
+
+	return nil
+}
+
+// UpdateByHash actualiza una bike por su hash.
+// Si cambia el precio guarda el precio anterior en la bike en la misma operacion y registra el cambio en price_history
 func (r *MongoRepository) UpdateByHash(ctx context.Context, hash string, update bson.M) *errorBikes.WrapperError {
-	filter := bson.M{"hash_byke": hash}
+	return r.updateOne(ctx, hash, bson.M{"hash_byke": hash}, update, false)
+}
+
+// UpdateByHashVersion actualiza una bike solo si su version es la recibida e incrementa la version.
+// Las bikes sin version se toman como version 0
+func (r *MongoRepository) UpdateByHashVersion(ctx context.Context, hash string, version int, update bson.M) *errorBikes.WrapperError {
+	filter := bson.M{"hash_byke": hash, "version": version}
+	if version == 0 {
+		filter["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	errUpdate := r.updateOne(ctx, hash, filter, update, true)
+	if errUpdate == nil || errUpdate.Type != errorBikes.ErrorBykeNotFound {
+		return errUpdate
+	}
 
-	updateDoc := bson.M{"$set": update}
-	result, err := r.client.UpdateOne(ctx, r.collectionName, filter, updateDoc)
+	// The filter also failed when the bike exists with another version
+	total, err := r.Count(ctx, bson.M{"hash_byke": hash})
 	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		newError := fmt.Errorf("byke with hash %s is not in version %d", hash, version)
+		return errorBikes.MapError(errorBikes.ErrorVersionConflict, newError)
+	}
+
+	return errUpdate
+}
+
+// updateOne aplica set a la bike del filtro, incrementando su version si se pide, y si set cambia el precio
+// registra el cambio en el historial
+func (r *MongoRepository) updateOne(ctx context.Context, hash string, filter bson.M, set bson.M, incrementVersion bool) *errorBikes.WrapperError {
+	newPrice, priceChanged := toInt(set["price"])
+	if !priceChanged {
+		updateDoc := bson.M{"$set": set}
+		if incrementVersion {
+			updateDoc["$inc"] = bson.M{"version": 1}
+		}
+
+		result, err := r.client.UpdateOne(ctx, r.collectionName, filter, updateDoc)
+		if err != nil {
+			newError := fmt.Errorf("failed to update bike: %w", err)
+			return errorBikes.MapError(errorBikes.ErrorUpdateByke, newError)
+		}
+
+		if result.MatchedCount == 0 {
+			newError := fmt.Errorf("byke with hash %s not found", hash)
+			return errorBikes.MapError(errorBikes.ErrorBykeNotFound, newError)
+		}
+
+		return nil
+	}
+
+	// Return the document before the update to know the old price
+	var before struct {
+		Price int `bson:"price"`
+	}
+	changedAt := time.Now().Unix()
+	findOpts := options.FindOneAndUpdate().SetReturnDocument(options.Before).SetProjection(bson.D{{Key: "price", Value: 1}})
+	err := r.client.FindOneAndUpdate(ctx, r.collectionName, filter, priceUpdatePipeline(set, newPrice, changedAt, incrementVersion), findOpts).Decode(&before)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			newError := fmt.Errorf("byke with hash %s not found", hash)
+			return errorBikes.MapError(errorBikes.ErrorBykeNotFound, newError)
+		}
 		newError := fmt.Errorf("failed to update bike: %w", err)
 		return errorBikes.MapError(errorBikes.ErrorUpdateByke, newError)
 	}
 
-	if result.MatchedCount == 0 {
-		newError := fmt.Errorf("byke with hash %s not found", hash)
-		return errorBikes.MapError(errorBikes.ErrorBykeNotFound, newError)
+	if before.Price == newPrice {
+		return nil
+	}
+
+	change := domain.PriceChange{
+		HashByke:  hash,
+		OldPrice:  before.Price,
+		NewPrice:  newPrice,
+		ChangedAt: changedAt,
+	}
+
+	if _, err := r.client.InsertOne(ctx, r.priceHistoryCollection, change); err != nil {
+		newError := fmt.Errorf("failed to insert price history: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoInsert, newError)
 	}
 
 	return nil
 }
 
+// priceUpdatePipeline arma la actualizacion de una bike como pipeline para que el precio anterior
+// se lea de $price y se guarde en la misma escritura que el precio nuevo.
+// Los campos de set van como $literal para que un texto que empiece por $ no se lea como campo
+func priceUpdatePipeline(set bson.M, newPrice int, changedAt int64, incrementVersion bool) bson.A {
+	changed := bson.M{"$ne": bson.A{"$price", newPrice}}
+
+	literals := bson.M{}
+	for field, value := range set {
+		literals[field] = bson.M{"$literal": value}
+	}
+	if incrementVersion {
+		literals["version"] = bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}}
+	}
+
+	return bson.A{
+		bson.M{"$set": bson.M{
+			"previous_price":   bson.M{"$cond": bson.A{changed, "$price", "$previous_price"}},
+			"price_dropped":    bson.M{"$cond": bson.A{changed, bson.M{"$lt": bson.A{newPrice, "$price"}}, "$price_dropped"}},
+			"price_changed_at": bson.M{"$cond": bson.A{changed, changedAt, "$price_changed_at"}},
+		}},
+		bson.M{"$set": literals},
+	}
+}
+
+// FindPriceHistory busca los cambios de precio de una bike del mas antiguo al mas reciente
+func (r *MongoRepository) FindPriceHistory(ctx context.Context, hash string) ([]*domain.PriceChange, *errorBikes.WrapperError) {
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "changed_at", Value: 1}}).
+		SetProjection(bson.D{{Key: "_id", Value: 0}})
+
+	cursor, err := r.client.Find(ctx, r.priceHistoryCollection, bson.M{"hash_byke": hash}, findOpts)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoFindAll, err)
+	}
+	defer cursor.Close(ctx)
+
+	changes := []*domain.PriceChange{}
+	if err := cursor.All(ctx, &changes); err != nil {
+		newError := fmt.Errorf("failed to decode price change: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return changes, nil
+}
+
+// toInt convierte el precio recibido en el update, que puede venir como cualquier tipo entero
+func toInt(value interface{}) (int, bool) {
+	switch number := value.(type) {
+	case int:
+		return number, true
+	case int32:
+		return int(number), true
+	case int64:
+		return int(number), true
+	case float64:
+		return int(number), true
+	default:
+		return 0, false
+	}
+}
+
 // DeleteByHash elimina una bike por su hash
 func (r *MongoRepository) DeleteByHash(ctx context.Context, hash string) *errorBikes.WrapperError {
 	filter := bson.M{"hash_byke": hash}
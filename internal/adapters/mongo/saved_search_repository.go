@@ -0,0 +1,158 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// SavedSearchRepository implementa el repositorio de búsquedas guardadas usando MongoDB
+type SavedSearchRepository struct {
+	client             ports.MongoClient
+	searchesCollection string
+	matchesCollection  string
+}
+
+// NewSavedSearchRepository crea el repositorio de búsquedas guardadas sobre sus dos colecciones
+func NewSavedSearchRepository(client ports.MongoClient, searchesCollection, matchesCollection string) ports.SavedSearchRepository {
+	return &SavedSearchRepository{
+		client:             client,
+		searchesCollection: searchesCollection,
+		matchesCollection:  matchesCollection,
+	}
+}
+
+// Insert guarda una nueva búsqueda
+func (r *SavedSearchRepository) Insert(ctx context.Context, search *domain.SavedSearch) *errorBikes.WrapperError {
+	if _, err := r.client.InsertOne(ctx, r.searchesCollection, search); err != nil {
+		newError := fmt.Errorf("failed to insert saved search: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoInsert, newError)
+	}
+
+	return nil
+}
+
+// FindByID busca una búsqueda guardada por su id
+func (r *SavedSearchRepository) FindByID(ctx context.Context, id string) (*domain.SavedSearch, *errorBikes.WrapperError) {
+	var search domain.SavedSearch
+	err := r.client.FindOne(ctx, r.searchesCollection, bson.M{"_id": id}).Decode(&search)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			newError := fmt.Errorf("saved search %s not found", id)
+			return nil, errorBikes.MapError(errorBikes.ErrorSavedSearchNotFound, newError)
+		}
+		newError := fmt.Errorf("failed to decode saved search: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return &search, nil
+}
+
+// FindAll busca las búsquedas guardadas que coincidan con el filtro
+func (r *SavedSearchRepository) FindAll(ctx context.Context, filter bson.M) ([]*domain.SavedSearch, *errorBikes.WrapperError) {
+	cursor, err := r.client.Find(ctx, r.searchesCollection, filter)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoFindAll, err)
+	}
+	defer cursor.Close(ctx)
+
+	searches := []*domain.SavedSearch{}
+	if err := cursor.All(ctx, &searches); err != nil {
+		newError := fmt.Errorf("failed to decode saved search: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return searches, nil
+}
+
+// InsertMatches registra las coincidencias, el índice único evita repetir la misma moto en una búsqueda
+func (r *SavedSearchRepository) InsertMatches(ctx context.Context, matches []*domain.SavedSearchMatch) *errorBikes.WrapperError {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(matches))
+	for _, match := range matches {
+		documents = append(documents, match)
+	}
+
+	_, err := r.client.InsertMany(ctx, r.matchesCollection, documents, options.InsertMany().SetOrdered(false))
+	if err != nil && !onlyDuplicateKeyErrors(err) {
+		newError := fmt.Errorf("failed to insert saved search matches: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoInsert, newError)
+	}
+
+	return nil
+}
+
+// FindMatches busca las coincidencias que cumplan el filtro
+func (r *SavedSearchRepository) FindMatches(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.SavedSearchMatch, *errorBikes.WrapperError) {
+	cursor, err := r.client.Find(ctx, r.matchesCollection, filter, opts...)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoFindAll, err)
+	}
+	defer cursor.Close(ctx)
+
+	matches := []*domain.SavedSearchMatch{}
+	if err := cursor.All(ctx, &matches); err != nil {
+		newError := fmt.Errorf("failed to decode saved search match: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	return matches, nil
+}
+
+// EnsureIndexes crea los índices de las búsquedas guardadas y sus coincidencias si no existen
+func (r *SavedSearchRepository) EnsureIndexes(ctx context.Context) *errorBikes.WrapperError {
+	ownerIndex := mongo.IndexModel{
+		Keys:    bson.D{{Key: "owner_id", Value: 1}},
+		Options: options.Index().SetName("saved_searches_owner"),
+	}
+
+	if _, err := r.client.GetCollection(r.searchesCollection).Indexes().CreateOne(ctx, ownerIndex); err != nil {
+		newError := fmt.Errorf("failed to create saved searches indexes: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoIndex, newError)
+	}
+
+	matchIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "search_id", Value: 1}, {Key: "hash_byke", Value: 1}},
+			Options: options.Index().SetName("saved_search_matches_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "search_id", Value: 1}, {Key: "_id", Value: 1}},
+			Options: options.Index().SetName("saved_search_matches_after"),
+		},
+	}
+
+	if _, err := r.client.GetCollection(r.matchesCollection).Indexes().CreateMany(ctx, matchIndexes); err != nil {
+		newError := fmt.Errorf("failed to create saved search matches indexes: %w", err)
+		return errorBikes.MapError(errorBikes.ErrorMongoIndex, newError)
+	}
+
+	return nil
+}
+
+// onlyDuplicateKeyErrors indica si todos los errores de un InsertMany son por llave duplicada
+func onlyDuplicateKeyErrors(err error) bool {
+	var bulkErr mongo.BulkWriteException
+	if errors.As(err, &bulkErr) {
+		if bulkErr.WriteConcernError != nil {
+			return false
+		}
+		for _, writeErr := range bulkErr.WriteErrors {
+			if writeErr.Code != 11000 {
+				return false
+			}
+		}
+		return true
+	}
+	return mongo.IsDuplicateKeyError(err)
+}
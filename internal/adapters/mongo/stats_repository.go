@@ -0,0 +1,114 @@
+package mongo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// statsTimezone es la zona horaria en la que se agrupan las publicaciones por dia y semana
+const statsTimezone = "America/Bogota"
+
+// StatsRepository implementa las estadísticas del catálogo con agregaciones de MongoDB
+type StatsRepository struct {
+	client         ports.MongoClient
+	collectionName string
+}
+
+// NewStatsRepository crea el repositorio de estadísticas sobre la colección de bikes
+func NewStatsRepository(client ports.MongoClient, collectionName string) ports.StatsRepository {
+	return &StatsRepository{
+		client:         client,
+		collectionName: collectionName,
+	}
+}
+
+// FindStats calcula con agregaciones las publicaciones por día y semana, los precios por marca y año,
+// la distribución de kilometraje en los rangos de kmBoundaries y las activas e inactivas
+func (r *StatsRepository) FindStats(ctx context.Context, filter bson.M, kmBoundaries []int64) (*domain.BikeStats, *errorBikes.WrapperError) {
+	// Bikes are dated by their publication and, when it is missing, by when they were found
+	listedAt := bson.D{{Key: "$toDate", Value: bson.D{{Key: "$multiply", Value: bson.A{
+		bson.D{{Key: "$cond", Value: bson.A{bson.D{{Key: "$gt", Value: bson.A{"$date_publish", 0}}}, "$date_publish", "$date_found"}}},
+		1000,
+	}}}}}
+
+	withPrice := bson.D{{Key: "$match", Value: bson.D{{Key: "price", Value: bson.D{{Key: "$gt", Value: 0}}}}}}
+	brand := bson.D{{Key: "$trim", Value: bson.D{{Key: "input", Value: "$brand"}}}}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$addFields", Value: bson.D{{Key: "listed_at", Value: listedAt}}}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "per_day", Value: countByPeriod("%Y-%m-%d")},
+			{Key: "per_week", Value: countByPeriod("%G-W%V")},
+			{Key: "by_brand", Value: bson.A{
+				withPrice,
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: bson.D{{Key: "$toLower", Value: brand}}},
+					{Key: "name", Value: bson.D{{Key: "$first", Value: brand}}},
+					{Key: "prices", Value: bson.D{{Key: "$push", Value: "$price"}}},
+				}}},
+				bson.D{{Key: "$match", Value: bson.D{{Key: "_id", Value: bson.D{{Key: "$nin", Value: bson.A{"", nil}}}}}}},
+			}},
+			{Key: "by_year", Value: bson.A{
+				withPrice,
+				bson.D{{Key: "$match", Value: bson.D{{Key: "year_model", Value: bson.D{{Key: "$gt", Value: 0}}}}}},
+				bson.D{{Key: "$group", Value: bson.D{
+					{Key: "_id", Value: "$year_model"},
+					{Key: "prices", Value: bson.D{{Key: "$push", Value: "$price"}}},
+				}}},
+				bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: -1}}}},
+			}},
+			{Key: "km", Value: bson.A{
+				// Negative or not numeric km would fall in the last bucket
+				bson.D{{Key: "$match", Value: bson.D{{Key: "km", Value: bson.D{{Key: "$gte", Value: 0}}}}}},
+				bson.D{{Key: "$bucket", Value: bson.D{
+					{Key: "groupBy", Value: "$km"},
+					{Key: "boundaries", Value: kmBoundaries},
+					{Key: "default", Value: kmBoundaries[len(kmBoundaries)-1]},
+					{Key: "output", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}},
+				}}},
+			}},
+			{Key: "status", Value: bson.A{
+				bson.D{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$active"}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+			}},
+		}}},
+	}
+
+	cursor, err := r.client.Aggregate(ctx, r.collectionName, pipeline)
+	if err != nil {
+		return nil, errorBikes.MapError(errorBikes.ErrorMongoAggregate, err)
+	}
+	defer cursor.Close(ctx)
+
+	var stats []*domain.BikeStats
+	if err := cursor.All(ctx, &stats); err != nil {
+		newError := fmt.Errorf("failed to decode stats: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorUnexpected, newError)
+	}
+
+	if len(stats) == 0 {
+		return &domain.BikeStats{}, nil
+	}
+
+	return stats[0], nil
+}
+
+// countByPeriod cuenta las publicaciones agrupadas por la fecha listed_at con el formato indicado
+func countByPeriod(format string) bson.A {
+	period := bson.D{{Key: "$dateToString", Value: bson.D{
+		{Key: "format", Value: format},
+		{Key: "date", Value: "$listed_at"},
+		{Key: "timezone", Value: statsTimezone},
+	}}}
+
+	return bson.A{
+		bson.D{{Key: "$group", Value: bson.D{{Key: "_id", Value: period}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+}
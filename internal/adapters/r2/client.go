@@ -3,6 +3,7 @@ package r2
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	configApp "github.com/Bikes2Road/bikes-compass/cmd/api/config"
@@ -63,6 +64,27 @@ func (c *NewClientR2) PresignGetObject(ctx context.Context, objectKey string, ex
 	return req.URL, nil
 }
 
+// maxObjectSize limita los bytes que se leen de un objeto
+const maxObjectSize = 20 << 20
+
+// GetObject descarga el contenido de un objeto
+func (c *NewClientR2) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	output, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting object: %w", err)
+	}
+	defer output.Body.Close()
+
+	content, err := io.ReadAll(io.LimitReader(output.Body, maxObjectSize))
+	if err != nil {
+		return nil, fmt.Errorf("error reading object: %w", err)
+	}
+	return content, nil
+}
+
 // GetBucketName retorna el nombre del bucket configurado
 func (c *NewClientR2) GetBucketName() string {
 	return c.bucketName
@@ -41,6 +41,24 @@ func (r *R2Repository) GetPresignedURL(ctx context.Context, objectKey string, ex
 	return url, nil
 }
 
+// GetObject descarga el contenido de un objeto del bucket
+func (r *R2Repository) GetObject(ctx context.Context, objectKey string) ([]byte, *errorBikes.WrapperError) {
+	if objectKey == "" {
+		newError := fmt.Errorf("object key cannot be empty")
+		return nil, errorBikes.MapError(errorBikes.ErrorR2KeyEmpty, newError)
+	}
+
+	key := fmt.Sprintf("n8n_bikes/%s", objectKey)
+
+	content, err := r.client.GetObject(ctx, key)
+	if err != nil {
+		newError := fmt.Errorf("failed to get object: %w", err)
+		return nil, errorBikes.MapError(errorBikes.ErrorR2GetObject, newError)
+	}
+
+	return content, nil
+}
+
 // GetBucketName retorna el nombre del bucket configurado
 func (r *R2Repository) GetBucketName() string {
 	return r.client.GetBucketName()
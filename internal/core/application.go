@@ -1,22 +1,96 @@
 package core
 
 import (
+	"context"
+
 	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
 	"github.com/Bikes2Road/bikes-compass/internal/core/services"
 )
 
 type Application struct {
-	GetAllBikes ports.GetAllBikes
-	GetByke     ports.GetByke
-	PlaceHolder ports.PlaceHolder
+	GetAllBikes  ports.GetAllBikes
+	GetByke      ports.GetByke
+	GetSimilar   ports.GetSimilarBikes
+	CompareBikes ports.CompareBikes
+	GetBatch     ports.GetBikesBatch
+	PriceHistory ports.GetPriceHistory
+	Valuation    ports.GetValuation
+
+	CreateSavedSearch     ports.CreateSavedSearch
+	GetSavedSearchMatches ports.GetSavedSearchMatches
+	EvaluateSavedSearches ports.EvaluateSavedSearches
+	RefreshDealScores     ports.Backfill
+	DetectDuplicates      ports.Backfill
+	DuplicateClusters     ports.GetDuplicateClusters
+	CreateByke            ports.CreateByke
+	UpdateByke            ports.UpdateByke
+	DeleteByke            ports.DeleteByke
+	PlaceHolder           ports.PlaceHolder
+	GetFacets             ports.GetFacets
+	GetLocations          ports.GetLocations
+	GetExtraTags          ports.GetExtraTags
+	GetBrands             ports.GetBrands
+	GetBrandModels        ports.GetBrandModels
+	GetStats              ports.GetStats
+	BackfillGeo           ports.Backfill
+	BackfillSpecs         ports.Backfill
+	BackfillDocuments     ports.Backfill
+	BackfillExtras        ports.Backfill
 }
 
-func NewApplication(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) Application {
+func NewApplication(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any], savedSearchRepository ports.SavedSearchRepository, statsRepository ports.StatsRepository, cursorSecret string) Application {
 	application := Application{
-		GetAllBikes: services.NewGetAllBikes(mongoRepository, r2Repository, cacheRepository),
-		GetByke:     services.NewGetByke(mongoRepository, r2Repository, cacheRepository),
-		PlaceHolder: services.NewPlaceHolder(mongoRepository),
+		GetAllBikes:  services.NewGetAllBikes(mongoRepository, r2Repository, cacheRepository, []byte(cursorSecret)),
+		GetByke:      services.NewGetByke(mongoRepository, r2Repository, cacheRepository),
+		GetSimilar:   services.NewGetSimilarBikes(mongoRepository, r2Repository, cacheRepository),
+		CompareBikes: services.NewCompareBikes(mongoRepository, r2Repository, cacheRepository),
+		GetBatch:     services.NewGetBikesBatch(mongoRepository, r2Repository, cacheRepository),
+		PriceHistory: services.NewGetPriceHistory(mongoRepository, cacheRepository),
+		Valuation:    services.NewGetValuation(mongoRepository, cacheRepository),
+
+		CreateSavedSearch:     services.NewCreateSavedSearch(savedSearchRepository),
+		GetSavedSearchMatches: services.NewGetSavedSearchMatches(savedSearchRepository),
+		EvaluateSavedSearches: services.NewEvaluateSavedSearches(mongoRepository, savedSearchRepository),
+		RefreshDealScores:     services.NewRefreshDealScores(mongoRepository),
+		DetectDuplicates:      services.NewDetectDuplicates(mongoRepository, r2Repository),
+		DuplicateClusters:     services.NewGetDuplicateClusters(mongoRepository),
+		CreateByke:            services.NewCreateByke(mongoRepository),
+		UpdateByke:            services.NewUpdateByke(mongoRepository, cacheRepository),
+		DeleteByke:            services.NewDeleteByke(mongoRepository, cacheRepository),
+		PlaceHolder:           services.NewPlaceHolder(mongoRepository),
+		GetFacets:             services.NewGetFacets(mongoRepository, cacheRepository),
+		GetLocations:          services.NewGetLocations(mongoRepository, cacheRepository),
+		GetExtraTags:          services.NewGetExtraTags(mongoRepository, cacheRepository),
+		GetBrands:             services.NewGetBrands(mongoRepository, cacheRepository),
+		GetBrandModels:        services.NewGetBrandModels(mongoRepository, cacheRepository),
+		GetStats:              services.NewGetStats(statsRepository, cacheRepository),
+		BackfillGeo:           services.NewBackfillGeo(mongoRepository),
+		BackfillSpecs:         services.NewBackfillSpecs(mongoRepository),
+		BackfillDocuments:     services.NewBackfillDocuments(mongoRepository),
+		BackfillExtras:        services.NewBackfillExtras(mongoRepository),
 	}
 
 	return application
 }
+
+// StartBackground arranca las tareas periodicas de la aplicacion, que se detienen al cancelar ctx.
+// El indice de /placeholder vive en memoria y se carga en cada replica; los procesos que recorren
+// la coleccion solo corren con runJobs, que se activa en una sola replica
+func (a Application) StartBackground(ctx context.Context, runJobs bool) {
+	go services.RunEvery(ctx, services.SuggestRefreshTime, a.PlaceHolder.Refresh)
+
+	if !runJobs {
+		return
+	}
+
+	// The ingestion flows insert directly into Mongo, so the derived fields are filled here
+	go services.RunEvery(ctx, services.BackfillRefreshTime, services.RunBackfills(
+		services.BackfillJob{Name: "geo", Job: a.BackfillGeo},
+		services.BackfillJob{Name: "specs", Job: a.BackfillSpecs},
+		services.BackfillJob{Name: "documents", Job: a.BackfillDocuments},
+		services.BackfillJob{Name: "extras", Job: a.BackfillExtras},
+		services.BackfillJob{Name: "duplicates", Job: a.DetectDuplicates},
+		services.BackfillJob{Name: "deals", Job: a.RefreshDealScores},
+	))
+	go services.RunEvery(ctx, services.SavedSearchEvaluateTime, a.EvaluateSavedSearches.Run)
+}
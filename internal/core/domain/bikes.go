@@ -1,33 +1,71 @@
 package domain
 
 type Bike struct {
-	ID            interface{} `json:"-" bson:"_id,omitempty"`
-	HashByke      string      `json:"hash_byke" bson:"hash_byke"`
-	Ref           string      `json:"ref" bson:"ref"`
-	Brand         string      `json:"brand" bson:"brand"`
-	Model         string      `json:"model" bson:"model"`
-	FullName      string      `json:"full_name" bson:"full_name"`
-	YearModel     int         `json:"year_model" bson:"year_model"`
-	Cylinder      string      `json:"cylinder" bson:"cylinder"`
-	Engine        string      `json:"engine" bson:"engine"`
-	HorsePower    string      `json:"horse_power" bson:"horse_power"`
-	Kilometers    int         `json:"km" bson:"km"`
-	Weight        string      `json:"weight" bson:"weight"`
-	CityRegister  string      `json:"city_register" bson:"city_register"`
-	Extras        []string    `json:"extras" bson:"extras,omitempty"`
-	DateFound     int         `json:"date_found" bson:"date_found"`
-	DatePublish   int         `json:"date_publish" bson:"date_publish"`
-	DateSoat      string      `json:"date_soat" bson:"date_soat"`
-	DateTecnico   string      `json:"date_tecnico" bson:"date_tecnico"`
-	Description   string      `json:"description" bson:"description,omitempty"`
-	PageInstagram string      `json:"page_instagram" bson:"page_instagram"`
-	Photos        [][]Photo   `json:"photos" bson:"photos"`
-	UrlPost       string      `json:"url_post" bson:"url_post"`
-	Price         int         `json:"price" bson:"price"`
-	Location      string      `json:"location" bson:"location"`
-	Active        bool        `json:"active" bson:"active"`
-	Reviewed      bool        `json:"reviewed" bson:"reviewed"`
-	Torque        string      `json:"torque" bson:"torque"`
+	ID               interface{}       `json:"-" bson:"_id,omitempty"`
+	HashByke         string            `json:"hash_byke" bson:"hash_byke"`
+	Ref              string            `json:"ref" bson:"ref"`
+	Brand            string            `json:"brand" bson:"brand"`
+	Model            string            `json:"model" bson:"model"`
+	FullName         string            `json:"full_name" bson:"full_name"`
+	YearModel        int               `json:"year_model" bson:"year_model"`
+	Cylinder         string            `json:"cylinder" bson:"cylinder"`
+	Engine           string            `json:"engine" bson:"engine"`
+	HorsePower       string            `json:"horse_power" bson:"horse_power"`
+	Kilometers       int               `json:"km" bson:"km"`
+	Weight           string            `json:"weight" bson:"weight"`
+	CityRegister     string            `json:"city_register" bson:"city_register"`
+	Extras           []string          `json:"extras" bson:"extras,omitempty"`
+	ExtrasTags       []string          `json:"extras_tags,omitempty" bson:"extras_tags,omitempty"`
+	DateFound        int               `json:"date_found" bson:"date_found"`
+	DatePublish      int               `json:"date_publish" bson:"date_publish"`
+	DateSoat         string            `json:"date_soat" bson:"date_soat"`
+	DateTecnico      string            `json:"date_tecnico" bson:"date_tecnico"`
+	Description      string            `json:"description" bson:"description,omitempty"`
+	PageInstagram    string            `json:"page_instagram" bson:"page_instagram"`
+	Photos           [][]Photo         `json:"photos" bson:"photos"`
+	UrlPost          string            `json:"url_post" bson:"url_post"`
+	Price            int               `json:"price" bson:"price"`
+	Location         string            `json:"location" bson:"location"`
+	Active           bool              `json:"active" bson:"active"`
+	Reviewed         bool              `json:"reviewed" bson:"reviewed"`
+	Torque           string            `json:"torque" bson:"torque"`
+	Geo              *GeoPoint         `json:"geo,omitempty" bson:"geo,omitempty"`
+	PreviousPrice    int               `json:"previous_price,omitempty" bson:"previous_price,omitempty"`
+	PriceDropped     bool              `json:"price_dropped" bson:"price_dropped,omitempty"`
+	DealScore        string            `json:"deal_score,omitempty" bson:"deal_score,omitempty"`
+	DealPercentage   *float64          `json:"deal_percentage,omitempty" bson:"deal_percentage,omitempty"`
+	DuplicateOf      string            `json:"duplicate_of,omitempty" bson:"duplicate_of,omitempty"`
+	PhotoHashes      map[string]string `json:"-" bson:"photo_hashes,omitempty"`
+	CylinderCc       *float64          `json:"cylinder_cc,omitempty" bson:"cylinder_cc,omitempty"`
+	HorsePowerHp     *float64          `json:"horse_power_hp,omitempty" bson:"horse_power_hp,omitempty"`
+	TorqueNm         *float64          `json:"torque_nm,omitempty" bson:"torque_nm,omitempty"`
+	WeightKg         *float64          `json:"weight_kg,omitempty" bson:"weight_kg,omitempty"`
+	SoatExpiresAt    *int64            `json:"soat_expires_at,omitempty" bson:"soat_expires_at,omitempty"`
+	TecnicoExpiresAt *int64            `json:"tecnico_expires_at,omitempty" bson:"tecnico_expires_at,omitempty"`
+	Version          int               `json:"version" bson:"version,omitempty"`
+}
+
+// PriceChange representa un cambio de precio de una moto
+type PriceChange struct {
+	// Hash de la moto
+	HashByke string `json:"hash_byke" bson:"hash_byke" example:"abcd1234abcd"`
+	// Precio antes del cambio
+	OldPrice int `json:"old_price" bson:"old_price" example:"27000000"`
+	// Precio después del cambio
+	NewPrice int `json:"new_price" bson:"new_price" example:"25000000"`
+	// Fecha del cambio (timestamp)
+	ChangedAt int64 `json:"changed_at" bson:"changed_at" example:"1731081212"`
+}
+
+// GeoPoint representa un punto GeoJSON con las coordenadas [lng, lat] de la ubicación
+type GeoPoint struct {
+	Type        string    `json:"type" bson:"type"`
+	Coordinates []float64 `json:"coordinates" bson:"coordinates"`
+}
+
+// NewGeoPoint crea un punto GeoJSON a partir de la latitud y longitud
+func NewGeoPoint(lat, lng float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{lng, lat}}
 }
 
 // swagger:model Photo
@@ -1,16 +1,222 @@
 package domain
 
+import "strings"
+
+// Ordenamientos soportados en la busqueda de motos
+const (
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortNewest    = "newest"
+	SortKmAsc     = "km_asc"
+	SortYearDesc  = "year_desc"
+	SortRelevance = "relevance"
+	SortDeal      = "deal"
+)
+
+// Calificaciones del precio de una moto frente al mercado
+const (
+	DealGreat = "great"
+	DealGood  = "good"
+	DealFair  = "fair"
+	DealHigh  = "high"
+)
+
+var ValidDeals = map[string]bool{
+	DealGreat: true,
+	DealGood:  true,
+	DealFair:  true,
+	DealHigh:  true,
+}
+
+// Estado de los documentos (SOAT y tecnico-mecanica) de una moto
+const (
+	DocumentValid    = "valid"
+	DocumentExpiring = "expiring"
+	DocumentExpired  = "expired"
+	DocumentUnknown  = "unknown"
+)
+
+// Modos de busqueda por nombre
+const (
+	SearchModeSubstring = "substring"
+	SearchModeText      = "text"
+)
+
+var ValidSorts = map[string]bool{
+	SortPriceAsc:  true,
+	SortPriceDesc: true,
+	SortNewest:    true,
+	SortKmAsc:     true,
+	SortYearDesc:  true,
+	SortRelevance: true,
+	SortDeal:      true,
+}
+
 type GetAllBikesRequest struct {
 	Name  string `form:"name" validate:"required"`
 	Page  int64  `form:"page" validate:"required"`
 	Cant  int64  `form:"cant" validate:"required"`
 	Brand string `form:"brand" validate:"required"`
+
+	PriceMin int64 `form:"price_min"`
+	PriceMax int64 `form:"price_max"`
+	YearMin  int64 `form:"year_min"`
+	YearMax  int64 `form:"year_max"`
+	KmMax    int64 `form:"km_max"`
+	CcMin    int64 `form:"cc_min"`
+	CcMax    int64 `form:"cc_max"`
+	HpMin    int64 `form:"hp_min"`
+
+	Deal      string `form:"deal"`
+	DocsValid bool   `form:"docs_valid"`
+	Extras    string `form:"extras"`
+	Sort      string `form:"sort"`
+	Cursor    string `form:"cursor"`
+	Mode      string `form:"mode"`
+
+	City       string `form:"city"`
+	Department string `form:"department"`
+
+	Lat      *float64 `form:"lat"`
+	Lng      *float64 `form:"lng"`
+	RadiusKm *float64 `form:"radius_km"`
+}
+
+// IsGeoSearch indica si la busqueda se limita a un radio alrededor de un punto
+func (r GetAllBikesRequest) IsGeoSearch() bool {
+	return r.Lat != nil && r.Lng != nil && r.RadiusKm != nil
+}
+
+// Deals retorna las calificaciones pedidas en el filtro deal separadas por coma
+func (r GetAllBikesRequest) Deals() []string {
+	return splitList(r.Deal)
+}
+
+// ExtraTags retorna las etiquetas de equipamiento pedidas en el filtro extras separadas por coma
+func (r GetAllBikesRequest) ExtraTags() []string {
+	return splitList(r.Extras)
+}
+
+// splitList separa una lista de valores por coma ignorando los vacios
+func splitList(value string) []string {
+	items := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// IsTextSearch indica si la busqueda por nombre usa el indice de texto
+func (r GetAllBikesRequest) IsTextSearch() bool {
+	return r.Mode == SearchModeText && r.Name != ""
 }
 
 type SearchBykeRequest struct {
 	HashByke string `uri:"hash_byke" binding:"required"`
 }
 
+type SimilarBikesRequest struct {
+	HashByke string `uri:"hash_byke" binding:"required"`
+	Cant     int64  `form:"cant"`
+}
+
+type CompareBikesRequest struct {
+	Hashes []string `form:"hash"`
+}
+
+type BatchBikesRequest struct {
+	Hashes []string `json:"hashes" binding:"required"`
+}
+
+type CreateSavedSearchRequest struct {
+	OwnerID string        `json:"owner_id" binding:"required"`
+	Filters SearchFilters `json:"filters"`
+}
+
+type SavedSearchMatchesRequest struct {
+	ID      string `uri:"id" binding:"required"`
+	OwnerID string `form:"owner_id" binding:"required"`
+	After   string `form:"after"`
+	Cant    int64  `form:"cant"`
+}
+
+type ValuationRequest struct {
+	Brand string `form:"brand" binding:"required"`
+	Model string `form:"model" binding:"required"`
+	Year  int64  `form:"year"`
+	Km    int64  `form:"km"`
+}
+
+type BrandModelsRequest struct {
+	Brand string `uri:"brand" binding:"required"`
+}
+
+type StatsRequest struct {
+	From  string `form:"from"`
+	To    string `form:"to"`
+	Brand string `form:"brand"`
+}
+
+// CreateBykeRequest es una publicacion nueva creada desde la administracion
+type CreateBykeRequest struct {
+	Ref           string    `json:"ref" example:"1234"`
+	Brand         string    `json:"brand" binding:"required" example:"Yamaha"`
+	Model         string    `json:"model" binding:"required" example:"MT-09"`
+	FullName      string    `json:"full_name" binding:"required" example:"Yamaha MT-09"`
+	YearModel     int       `json:"year_model" binding:"required" example:"2021"`
+	Cylinder      string    `json:"cylinder" example:"889 cc"`
+	Engine        string    `json:"engine" example:"Tricilindrico 4T"`
+	HorsePower    string    `json:"horse_power" example:"117 HP @ 10000rpm"`
+	Torque        string    `json:"torque" example:"93 Nm"`
+	Weight        string    `json:"weight" example:"189 kg"`
+	Kilometers    *int      `json:"km" binding:"required" example:"12000"`
+	Price         int       `json:"price" binding:"required" example:"45000000"`
+	Location      string    `json:"location" binding:"required" example:"Medellín - Antioquia"`
+	CityRegister  string    `json:"city_register" example:"Envigado"`
+	Extras        []string  `json:"extras" example:"ABS,maletas laterales"`
+	Description   string    `json:"description" example:"Unico dueño, mantenimientos en concesionario"`
+	DatePublish   int       `json:"date_publish" example:"1731081212"`
+	DateSoat      string    `json:"date_soat" example:"15/03/2026"`
+	DateTecnico   string    `json:"date_tecnico" example:"03/2026"`
+	PageInstagram string    `json:"page_instagram" binding:"required" example:"motos_medellin"`
+	UrlPost       string    `json:"url_post" binding:"required" example:"https://www.instagram.com/p/abc123"`
+	Photos        [][]Photo `json:"photos" swaggertype:"array,array,object"`
+	// Por defecto la publicacion se crea activa
+	Active *bool `json:"active" example:"true"`
+}
+
+// UpdateBykeRequest son los campos editables de una publicacion desde la administracion,
+// los campos que no se envian no se modifican
+type UpdateBykeRequest struct {
+	Ref           *string    `json:"ref" example:"1234"`
+	Brand         *string    `json:"brand" example:"Yamaha"`
+	Model         *string    `json:"model" example:"MT-09"`
+	FullName      *string    `json:"full_name" example:"Yamaha MT-09"`
+	YearModel     *int       `json:"year_model" example:"2021"`
+	Cylinder      *string    `json:"cylinder" example:"889 cc"`
+	Engine        *string    `json:"engine" example:"Tricilindrico 4T"`
+	HorsePower    *string    `json:"horse_power" example:"117 HP @ 10000rpm"`
+	Torque        *string    `json:"torque" example:"93 Nm"`
+	Weight        *string    `json:"weight" example:"189 kg"`
+	Kilometers    *int       `json:"km" example:"12000"`
+	Price         *int       `json:"price" example:"43000000"`
+	Location      *string    `json:"location" example:"Medellín - Antioquia"`
+	CityRegister  *string    `json:"city_register" example:"Envigado"`
+	Extras        *[]string  `json:"extras" example:"ABS,maletas laterales"`
+	Description   *string    `json:"description" example:"Unico dueño, mantenimientos en concesionario"`
+	DateSoat      *string    `json:"date_soat" example:"15/03/2026"`
+	DateTecnico   *string    `json:"date_tecnico" example:"03/2026"`
+	PageInstagram *string    `json:"page_instagram" example:"motos_medellin"`
+	UrlPost       *string    `json:"url_post" example:"https://www.instagram.com/p/abc123"`
+	Photos        *[][]Photo `json:"photos" swaggertype:"array,array,object"`
+	Active        *bool      `json:"active" example:"false"`
+	Reviewed      *bool      `json:"reviewed" example:"true"`
+	// Versión leída de la publicación, alternativa al header If-Match
+	Version *int `json:"version" example:"3"`
+}
+
 type PlaceHolderRequest struct {
 	NameByke string `form:"name" validate:"required"`
 }
@@ -7,8 +7,18 @@ type GetAllResponseSuccess struct {
 	Success bool `json:"success" validate:"required" example:"true"`
 	// Lista de motos encontradas
 	Data []*BykeReponse `json:"data" validate:"required" swaggertype:"array,object"`
-	// Número total de registros encontrados
+	// Número total de registros que coinciden con la búsqueda
 	Total int64 `json:"total" validate:"required" example:"10"`
+	// Página actual
+	Page int64 `json:"page" validate:"required" example:"1"`
+	// Cantidad de registros por página
+	PageSize int64 `json:"page_size" validate:"required" example:"10"`
+	// Número total de páginas
+	TotalPages int64 `json:"total_pages" validate:"required" example:"1"`
+	// Indica si existe una página siguiente
+	HasNext bool `json:"has_next" validate:"required" example:"false"`
+	// Cursor opaco para solicitar la siguiente página
+	NextCursor string `json:"next_cursor,omitempty" example:"eyJzIjoibmV3ZXN0In0.c2lnbmF0dXJl"`
 }
 
 type GetBykeResponseSuccess struct {
@@ -21,9 +31,19 @@ type GetBykeResponseSuccess struct {
 }
 
 type PlaceHolderResponseSuccess struct {
-	Success bool     `json:"success" validate:"required" example:"true"`
-	Data    []string `json:"data" validate:"required" example:"[\"Ducati\",\"BMW\"]"`
-	Total   int64    `json:"total" validate:"required" example:"2"`
+	Success bool                    `json:"success" validate:"required" example:"true"`
+	Data    []PlaceHolderSuggestion `json:"data" validate:"required"`
+	Total   int64                   `json:"total" validate:"required" example:"2"`
+}
+
+// PlaceHolderSuggestion representa una sugerencia del autocompletado
+type PlaceHolderSuggestion struct {
+	// Texto sugerido
+	Value string `json:"value" example:"Yamaha"`
+	// Categoría de la sugerencia: brand, model o full_name
+	Category string `json:"category" example:"brand"`
+	// Cantidad de publicaciones que tienen este valor
+	Count int64 `json:"count" example:"42"`
 }
 
 // swagger:model ResponseHttpError
@@ -40,17 +60,19 @@ type ResponseHttpError struct {
 }
 
 type FullBykeResponse struct {
-	Ref           string    `json:"ref" bson:"ref" example:"1234"`
-	HashByke      string    `json:"hash_byke" bson:"hash_byke" example:"abcd1234"`
-	FullName      string    `json:"full_name" bson:"full_name" example:"Yamaha MT-03"`
-	Brand         string    `json:"brand" bson:"brand"`
-	Model         string    `json:"model" bson:"model"`
-	Cylinder      string    `json:"cylinder" bson:"cylinder"`
-	Engine        string    `json:"engine" bson:"engine"`
-	HorsePower    string    `json:"horse_power" bson:"horse_power"`
-	Weight        string    `json:"weight" bson:"weight"`
-	CityRegister  string    `json:"city_register" bson:"city_register"`
-	Extras        []string  `json:"extras" bson:"extras,omitempty"`
+	Ref          string   `json:"ref" bson:"ref" example:"1234"`
+	HashByke     string   `json:"hash_byke" bson:"hash_byke" example:"abcd1234"`
+	FullName     string   `json:"full_name" bson:"full_name" example:"Yamaha MT-03"`
+	Brand        string   `json:"brand" bson:"brand"`
+	Model        string   `json:"model" bson:"model"`
+	Cylinder     string   `json:"cylinder" bson:"cylinder"`
+	Engine       string   `json:"engine" bson:"engine"`
+	HorsePower   string   `json:"horse_power" bson:"horse_power"`
+	Weight       string   `json:"weight" bson:"weight"`
+	CityRegister string   `json:"city_register" bson:"city_register"`
+	Extras       []string `json:"extras" bson:"extras,omitempty"`
+	// Etiquetas canónicas del equipamiento, ver /extras
+	ExtrasTags    []string  `json:"extras_tags,omitempty" bson:"extras_tags,omitempty" example:"abs,side_cases"`
 	DateFound     int       `json:"date_found" bson:"date_found"`
 	DateSoat      string    `json:"date_soat" bson:"date_soat"`
 	DateTecnico   string    `json:"date_tecnico" bson:"date_tecnico"`
@@ -63,6 +85,30 @@ type FullBykeResponse struct {
 	DatePublish   int       `json:"date_publish" bson:"date_publish" example:"1731081212"`
 	Photos        [][]Photo `json:"photos" bson:"photos" swaggertype:"array,array,object"`
 	Torque        string    `json:"torque" bson:"torque"`
+	PreviousPrice int       `json:"previous_price,omitempty" bson:"previous_price,omitempty" example:"27000000"`
+	PriceDropped  bool      `json:"price_dropped" bson:"price_dropped,omitempty" example:"true"`
+	// Calificación del precio frente al mercado: great, good, fair o high
+	DealScore string `json:"deal_score,omitempty" bson:"deal_score,omitempty" example:"good"`
+	// Porcentaje del precio frente al precio estimado de mercado, negativo es más barato
+	DealPercentage *float64 `json:"deal_percentage,omitempty" bson:"deal_percentage,omitempty" example:"-8.5"`
+	// Cilindraje en cc interpretado de cylinder
+	CylinderCc *float64 `json:"cylinder_cc,omitempty" bson:"cylinder_cc,omitempty" example:"689"`
+	// Potencia en hp interpretada de horse_power
+	HorsePowerHp *float64 `json:"horse_power_hp,omitempty" bson:"horse_power_hp,omitempty" example:"73.4"`
+	// Torque en Nm interpretado de torque
+	TorqueNm *float64 `json:"torque_nm,omitempty" bson:"torque_nm,omitempty" example:"68"`
+	// Peso en kg interpretado de weight
+	WeightKg *float64 `json:"weight_kg,omitempty" bson:"weight_kg,omitempty" example:"189"`
+	// Estado del SOAT: valid, expiring, expired o unknown
+	SoatStatus string `json:"soat_status" bson:"-" example:"valid"`
+	// Días que faltan para que venza el SOAT, negativo si ya venció
+	SoatDaysLeft *int `json:"soat_days_left,omitempty" bson:"-" example:"120"`
+	// Estado de la revisión técnico-mecánica: valid, expiring, expired o unknown
+	TecnicoStatus string `json:"tecnico_status" bson:"-" example:"expiring"`
+	// Días que faltan para que venza la técnico-mecánica, negativo si ya venció
+	TecnicoDaysLeft *int `json:"tecnico_days_left,omitempty" bson:"-" example:"12"`
+	// Versión de la publicación, se envía en If-Match para editarla desde la administración
+	Version int `json:"version" bson:"version,omitempty" example:"3"`
 }
 
 // swagger:model BykeReponse
@@ -80,16 +126,452 @@ type BykeReponse struct {
 	Kilometers int `json:"km" bson:"km" example:"1235"`
 	// Precio de la moto
 	Price int `json:"price" bson:"price" example:"25000000"`
+	// Precio antes del último cambio
+	PreviousPrice int `json:"previous_price,omitempty" bson:"previous_price,omitempty" example:"27000000"`
+	// Indica si el último cambio de precio fue una rebaja
+	PriceDropped bool `json:"price_dropped" bson:"price_dropped,omitempty" example:"true"`
+	// Calificación del precio frente al mercado: great, good, fair o high
+	DealScore string `json:"deal_score,omitempty" bson:"deal_score,omitempty" example:"good"`
+	// Porcentaje del precio frente al precio estimado de mercado, negativo es más barato
+	DealPercentage *float64 `json:"deal_percentage,omitempty" bson:"deal_percentage,omitempty" example:"-8.5"`
 	// Ciudad o región donde está ubicada
 	Location string `json:"location" bson:"location" example:"Bogotá D.C"`
 	// Fecha de publicación (timestamp)
 	DatePublish int `json:"date_publish" bson:"date_publish" example:"1731081212"`
 	// Fotos asociadas a la moto
 	Photos [][]Photo `json:"photos" bson:"photos" swaggertype:"array,array,object"`
+	// Relevancia de la moto en la búsqueda por texto
+	Score float64 `json:"score,omitempty" bson:"score,omitempty" example:"1.5"`
+	// Nombre con los términos encontrados resaltados en la búsqueda por texto
+	Highlight string `json:"highlight,omitempty" bson:"-" example:"<em>Yamaha</em> MT-03"`
+	// Distancia en km desde el punto de la búsqueda por cercanía
+	Distance *float64 `json:"distance_km,omitempty" bson:"-" example:"12.4"`
+	// Ubicación GeoJSON de la moto
+	Geo *GeoPoint `json:"-" bson:"geo,omitempty"`
+	// Qué tan parecida es a la moto consultada, de 0 a 1
+	Similarity float64 `json:"similarity,omitempty" bson:"-" example:"0.82"`
+}
+
+// swagger:model SimilarBikesResponseSuccess
+// SimilarBikesResponseSuccess representa las motos parecidas a una publicación.
+type SimilarBikesResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Motos ordenadas de la más a la menos parecida
+	Data []*BykeReponse `json:"data" validate:"required" swaggertype:"array,object"`
+	// Número de motos retornadas
+	Total int64 `json:"total" validate:"required" example:"6"`
+}
+
+// swagger:model CompareResponseSuccess
+// CompareResponseSuccess representa la comparación lado a lado de varias motos.
+type CompareResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Motos comparadas y filas de especificaciones alineadas
+	Data *BikeComparison `json:"data" validate:"required"`
+}
+
+// BikeComparison agrupa las motos comparadas y sus especificaciones en el mismo orden
+type BikeComparison struct {
+	// Motos en el orden en que se pidieron
+	Bikes []*FullBykeResponse `json:"bikes" validate:"required"`
+	// Una fila por especificación con un valor por moto
+	Rows []ComparisonRow `json:"rows" validate:"required"`
+	// Hashes que no se encontraron
+	Missing []string `json:"missing" validate:"required"`
+}
+
+// ComparisonRow representa una especificación de todas las motos comparadas
+type ComparisonRow struct {
+	// Especificación comparada
+	Spec string `json:"spec" example:"horse_power"`
+	// Unidad del valor numérico
+	Unit string `json:"unit" example:"hp"`
+	// Indica si el mejor valor es el mayor (max) o el menor (min), vacío si la fila no se compara
+	Better string `json:"better,omitempty" example:"max"`
+	// Valores en el mismo orden de las motos
+	Values []ComparisonValue `json:"values"`
+}
+
+// ComparisonValue representa el valor de una especificación para una moto
+type ComparisonValue struct {
+	// Hash de la moto
+	HashByke string `json:"hash_byke" example:"abcd1234abcd"`
+	// Valor publicado
+	Raw string `json:"raw" example:"42 CV"`
+	// Valor convertido a la unidad de la fila, null si no se pudo interpretar
+	Value *float64 `json:"value" example:"41.4"`
+	// Indica si es el mejor valor de la fila
+	Best bool `json:"best" example:"true"`
+}
+
+// swagger:model BatchBikesResponseSuccess
+// BatchBikesResponseSuccess representa el detalle de varias motos consultadas por hash.
+type BatchBikesResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Motos encontradas en el orden en que se pidieron
+	Data []*FullBykeResponse `json:"data" validate:"required"`
+	// Hashes que no se encontraron
+	Missing []string `json:"missing" validate:"required"`
+	// Número de motos encontradas
+	Total int64 `json:"total" validate:"required" example:"2"`
+}
+
+// swagger:model SavedSearchResponseSuccess
+// SavedSearchResponseSuccess representa una búsqueda guardada.
+type SavedSearchResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Búsqueda guardada
+	Data *SavedSearch `json:"data" validate:"required"`
+}
+
+// swagger:model SavedSearchMatchesResponseSuccess
+// SavedSearchMatchesResponseSuccess representa las motos nuevas que cumplen una búsqueda guardada.
+type SavedSearchMatchesResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Coincidencias ordenadas de la más antigua a la más reciente
+	Data []*SavedSearchMatch `json:"data" validate:"required"`
+	// Número de coincidencias retornadas
+	Total int64 `json:"total" validate:"required" example:"3"`
+}
+
+// swagger:model PriceHistoryResponseSuccess
+// PriceHistoryResponseSuccess representa los cambios de precio de una moto.
+type PriceHistoryResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Cambios de precio del más antiguo al más reciente
+	Data []*PriceChange `json:"data" validate:"required"`
+	// Precio actual de la moto
+	CurrentPrice int `json:"current_price" validate:"required" example:"25000000"`
+	// Número de cambios de precio
+	Total int64 `json:"total" validate:"required" example:"2"`
+}
+
+// swagger:model ValuationResponseSuccess
+// ValuationResponseSuccess representa el precio estimado de mercado de una moto.
+type ValuationResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Valoración de la moto
+	Data *Valuation `json:"data" validate:"required"`
+}
+
+// Valuation representa el precio justo estimado a partir de motos comparables
+type Valuation struct {
+	// Marca consultada
+	Brand string `json:"brand" example:"Yamaha"`
+	// Modelo consultado
+	Model string `json:"model" example:"MT-03"`
+	// Año consultado, 0 si no se envió
+	Year int64 `json:"year" example:"2021"`
+	// Kilometraje consultado, 0 si no se envió
+	Km int64 `json:"km" example:"15000"`
+	// Precio estimado ajustado por año y kilometraje
+	Estimate int64 `json:"estimate" example:"21500000"`
+	// Límite inferior del rango de precio justo
+	Low int64 `json:"low" example:"20000000"`
+	// Límite superior del rango de precio justo
+	High int64 `json:"high" example:"23000000"`
+	// Mediana de precio del segmento
+	Median int64 `json:"median" example:"21000000"`
+	// Primer cuartil de precio del segmento
+	Q1 int64 `json:"q1" example:"19500000"`
+	// Tercer cuartil de precio del segmento
+	Q3 int64 `json:"q3" example:"22500000"`
+	// Rango intercuartil de precio del segmento
+	IQR int64 `json:"iqr" example:"3000000"`
+	// Cantidad de motos comparables usadas
+	SampleSize int64 `json:"sample_size" example:"14"`
+	// Confianza de la estimación: high, medium o low
+	Confidence string `json:"confidence" example:"medium"`
+	// Depreciación estimada del segmento
+	Depreciation Depreciation `json:"depreciation"`
+}
+
+// Depreciation representa cuánto cambia el precio con el año y el kilometraje
+type Depreciation struct {
+	// Cuánto sube el precio por cada año más nuevo
+	PerYear int64 `json:"per_year" example:"1200000"`
+	// Cuánto baja el precio por cada 1000 km
+	Per1000Km int64 `json:"per_1000_km" example:"80000"`
+}
+
+// BackfillResult representa el resultado de un proceso que completa campos en motos existentes
+type BackfillResult struct {
+	// Motos revisadas
+	Processed int64 `json:"processed" example:"120"`
+	// Motos actualizadas
+	Updated int64 `json:"updated" example:"110"`
+	// Motos que no se pudieron completar
+	Skipped int64 `json:"skipped" example:"10"`
+}
+
+// swagger:model FacetsResponseSuccess
+// FacetsResponseSuccess representa los conteos de motos por categoría para los filtros.
+type FacetsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Conteos por marca, ubicación, año, precio y kilometraje
+	Data *BikeFacets `json:"data" validate:"required"`
+}
+
+// BikeFacets agrupa los conteos de motos por cada categoría de filtro
+type BikeFacets struct {
+	// Conteo por marca
+	Brands []FacetCount `json:"brands" bson:"brands"`
+	// Conteo por municipio del catálogo DANE, ver /locations
+	Locations []LocationCount `json:"locations" bson:"-"`
+	// Conteo por el texto de la ubicación tal como se publicó
+	RawLocations []FacetCount `json:"-" bson:"locations"`
+	// Conteo por año del modelo
+	Years []YearFacetCount `json:"years" bson:"years"`
+	// Conteo por rango de precio
+	Prices []RangeFacetCount `json:"prices" bson:"prices"`
+	// Conteo por rango de kilometraje
+	Kilometers []RangeFacetCount `json:"km" bson:"km"`
+}
+
+// FacetCount representa la cantidad de motos para un valor de texto
+type FacetCount struct {
+	Value string `json:"value" bson:"_id" example:"Yamaha"`
+	Count int64  `json:"count" bson:"count" example:"42"`
+}
+
+// YearFacetCount representa la cantidad de motos para un año del modelo
+type YearFacetCount struct {
+	Year  int   `json:"year" bson:"_id" example:"2020"`
+	Count int64 `json:"count" bson:"count" example:"12"`
+}
+
+// RangeFacetCount representa la cantidad de motos dentro de un rango [min, max).
+// Max en 0 indica que el rango no tiene límite superior
+type RangeFacetCount struct {
+	Min   int64 `json:"min" bson:"_id" example:"10000000"`
+	Max   int64 `json:"max" bson:"-" example:"20000000"`
+	Count int64 `json:"count" bson:"count" example:"8"`
+}
+
+// swagger:model LocationsResponseSuccess
+// LocationsResponseSuccess representa los municipios con motos publicadas.
+type LocationsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Municipios con la cantidad de motos publicadas
+	Data []LocationCount `json:"data" validate:"required"`
+	// Número total de municipios
+	Total int64 `json:"total" validate:"required" example:"1"`
+}
+
+// LocationCount representa un municipio del catálogo DANE y la cantidad de motos publicadas en él
+type LocationCount struct {
+	// Código DANE del municipio
+	Code string `json:"code" example:"11001"`
+	// Nombre del municipio
+	City string `json:"city" example:"Bogotá D.C."`
+	// Código DANE del departamento
+	DepartmentCode string `json:"department_code" example:"11"`
+	// Nombre del departamento
+	Department string `json:"department" example:"Bogotá D.C."`
+	// Cantidad de motos publicadas
+	Count int64 `json:"count" example:"42"`
+}
+
+// swagger:model DuplicateClustersResponseSuccess
+// DuplicateClustersResponseSuccess representa los grupos de publicaciones duplicadas.
+type DuplicateClustersResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Grupos de publicaciones que son la misma moto
+	Data []DuplicateCluster `json:"data" validate:"required"`
+	// Número total de grupos
+	Total int64 `json:"total" validate:"required" example:"1"`
+}
+
+// DuplicateCluster representa un grupo de publicaciones de la misma moto en distintas páginas
+type DuplicateCluster struct {
+	// Hash de la publicación canónica, es el id del grupo
+	GroupID string `json:"group_id" example:"abcd1234abcd"`
+	// Publicaciones del grupo, la canónica primero
+	Members []DuplicateMember `json:"members"`
+}
+
+// DuplicateMember representa una publicación dentro de un grupo de duplicados
+type DuplicateMember struct {
+	HashByke      string `json:"hash_byke" example:"abcd1234abcd"`
+	FullName      string `json:"full_name" example:"Yamaha MT-09"`
+	YearModel     int    `json:"year_model" example:"2021"`
+	Kilometers    int    `json:"km" example:"12000"`
+	Price         int    `json:"price" example:"45000000"`
+	Location      string `json:"location" example:"Medellín - Antioquia"`
+	PageInstagram string `json:"page_instagram" example:"motos_medellin"`
+	UrlPost       string `json:"url_post" example:"https://www.instagram.com/p/abc123"`
+	DatePublish   int    `json:"date_publish" example:"1731081212"`
+	// Indica si es la publicación que se muestra en /search
+	Canonical bool `json:"canonical" example:"true"`
+}
+
+// swagger:model ExtraTagsResponseSuccess
+// ExtraTagsResponseSuccess representa las etiquetas de equipamiento disponibles para filtrar.
+type ExtraTagsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Etiquetas con la cantidad de motos publicadas que las tienen
+	Data []ExtraTagCount `json:"data" validate:"required"`
+	// Número total de etiquetas
+	Total int64 `json:"total" validate:"required" example:"1"`
+}
+
+// ExtraTagCount representa una etiqueta de equipamiento y la cantidad de motos publicadas con ella
+type ExtraTagCount struct {
+	// Etiqueta canónica, es el valor del filtro extras
+	Tag string `json:"tag" example:"side_cases"`
+	// Nombre para mostrar
+	Label string `json:"label" example:"Maletas laterales"`
+	// Cantidad de motos publicadas
+	Count int64 `json:"count" example:"12"`
+}
+
+// swagger:model BrandsResponseSuccess
+// BrandsResponseSuccess representa las marcas con motos publicadas.
+type BrandsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Marcas con la cantidad de motos y el rango de años
+	Data []CatalogEntry `json:"data" validate:"required"`
+	// Número total de marcas
+	Total int64 `json:"total" validate:"required" example:"1"`
+}
+
+// swagger:model ModelsResponseSuccess
+// ModelsResponseSuccess representa los modelos de una marca con motos publicadas.
+type ModelsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Marca consultada
+	Brand string `json:"brand" validate:"required" example:"Yamaha"`
+	// Modelos con la cantidad de motos y el rango de años
+	Data []CatalogEntry `json:"data" validate:"required"`
+	// Número total de modelos
+	Total int64 `json:"total" validate:"required" example:"1"`
+}
+
+// CatalogEntry representa una marca o un modelo con la cantidad de motos publicadas y el rango de años
+type CatalogEntry struct {
+	// Nombre de la marca o el modelo
+	Name string `json:"name" bson:"name" example:"Yamaha"`
+	// Cantidad de motos publicadas
+	Count int64 `json:"count" bson:"count" example:"42"`
+	// Año del modelo más antiguo
+	YearMin int `json:"year_min" bson:"year_min" example:"2012"`
+	// Año del modelo más reciente
+	YearMax int `json:"year_max" bson:"year_max" example:"2024"`
+}
+
+// swagger:model StatsResponseSuccess
+// StatsResponseSuccess representa las estadísticas del catálogo para los tableros.
+type StatsResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Estadísticas del catálogo en el rango de fechas
+	Data *CatalogStats `json:"data" validate:"required"`
+}
+
+// CatalogStats representa las estadísticas de las publicaciones en un rango de fechas
+type CatalogStats struct {
+	// Fecha inicial del rango (YYYY-MM-DD)
+	From string `json:"from" example:"2025-01-01"`
+	// Fecha final del rango, incluida (YYYY-MM-DD)
+	To string `json:"to" example:"2025-01-31"`
+	// Marca filtrada
+	Brand string `json:"brand,omitempty" example:"Yamaha"`
+	// Publicaciones en el rango
+	Total int64 `json:"total" example:"120"`
+	// Publicaciones activas
+	Active int64 `json:"active" example:"90"`
+	// Publicaciones inactivas (vendidas o retiradas)
+	Inactive int64 `json:"inactive" example:"30"`
+	// Publicaciones nuevas por día
+	ListingsPerDay []PeriodCount `json:"listings_per_day"`
+	// Publicaciones nuevas por semana ISO
+	ListingsPerWeek []PeriodCount `json:"listings_per_week"`
+	// Precio promedio y mediana por marca
+	PriceByBrand []PriceStats `json:"price_by_brand"`
+	// Precio promedio y mediana por año del modelo
+	PriceByYear []PriceStats `json:"price_by_year"`
+	// Distribución por rango de kilometraje
+	Kilometers []RangeFacetCount `json:"km"`
+}
+
+// PeriodCount representa la cantidad de publicaciones nuevas en un día (YYYY-MM-DD) o semana (YYYY-Www)
+type PeriodCount struct {
+	Period string `json:"period" example:"2025-01-15"`
+	Count  int64  `json:"count" example:"4"`
+}
+
+// PriceStats representa el precio promedio y la mediana de un grupo de publicaciones
+type PriceStats struct {
+	// Marca o año del grupo
+	Group   string `json:"group" example:"Yamaha"`
+	Count   int64  `json:"count" example:"42"`
+	Average int64  `json:"average" example:"24500000"`
+	Median  int64  `json:"median" example:"23000000"`
+}
+
+// BikeStats es el resultado de la agregación de estadísticas, antes de calcular promedios y medianas
+type BikeStats struct {
+	PerDay     []FacetCount      `bson:"per_day"`
+	PerWeek    []FacetCount      `bson:"per_week"`
+	ByBrand    []PriceGroup      `bson:"by_brand"`
+	ByYear     []YearPriceGroup  `bson:"by_year"`
+	Kilometers []RangeFacetCount `bson:"km"`
+	Status     []StatusCount     `bson:"status"`
+}
+
+// PriceGroup son los precios de las publicaciones de una marca
+type PriceGroup struct {
+	Name   string  `bson:"name"`
+	Prices []int64 `bson:"prices"`
+}
+
+// YearPriceGroup son los precios de las publicaciones de un año del modelo
+type YearPriceGroup struct {
+	Year   int     `bson:"_id"`
+	Prices []int64 `bson:"prices"`
+}
+
+// StatusCount es la cantidad de publicaciones activas o inactivas
+type StatusCount struct {
+	Active bool  `bson:"_id"`
+	Count  int64 `bson:"count"`
+}
+
+// swagger:model CreateBykeResponseSuccess
+// CreateBykeResponseSuccess representa la publicación creada desde la administración.
+type CreateBykeResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Publicación creada, pendiente de revisión
+	Data *Bike `json:"data" validate:"required"`
+}
+
+// swagger:model UpdateBykeResponseSuccess
+// UpdateBykeResponseSuccess representa la publicación editada desde la administración.
+type UpdateBykeResponseSuccess struct {
+	// Indica si la petición fue exitosa
+	Success bool `json:"success" validate:"required" example:"true"`
+	// Publicación con los cambios aplicados y su nueva versión
+	Data *Bike `json:"data" validate:"required"`
 }
 
 type BykeName struct {
 	FullName string `json:"full_name" bson:"full_name"`
+	Brand    string `json:"brand" bson:"brand"`
+	Model    string `json:"model" bson:"model"`
 }
 
 type HealthResponse struct {
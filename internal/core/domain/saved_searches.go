@@ -0,0 +1,76 @@
+package domain
+
+import "go.mongodb.org/mongo-driver/v2/bson"
+
+// SavedSearch representa una busqueda guardada por un usuario para ser avisado de motos nuevas
+type SavedSearch struct {
+	ID        string        `json:"id" bson:"_id" example:"6650c0d2f1a2b3c4d5e6f708"`
+	OwnerID   string        `json:"owner_id" bson:"owner_id" example:"user-123"`
+	Filters   SearchFilters `json:"filters" bson:"filters"`
+	CreatedAt int64         `json:"created_at" bson:"created_at" example:"1731081212"`
+}
+
+// SearchFilters son los filtros de /search que se pueden guardar
+type SearchFilters struct {
+	Name       string   `json:"name,omitempty" bson:"name,omitempty" example:"MT-03"`
+	Brand      string   `json:"brand,omitempty" bson:"brand,omitempty" example:"Yamaha"`
+	PriceMin   int64    `json:"price_min,omitempty" bson:"price_min,omitempty" example:"10000000"`
+	PriceMax   int64    `json:"price_max,omitempty" bson:"price_max,omitempty" example:"25000000"`
+	YearMin    int64    `json:"year_min,omitempty" bson:"year_min,omitempty" example:"2018"`
+	YearMax    int64    `json:"year_max,omitempty" bson:"year_max,omitempty" example:"2024"`
+	KmMax      int64    `json:"km_max,omitempty" bson:"km_max,omitempty" example:"30000"`
+	CcMin      int64    `json:"cc_min,omitempty" bson:"cc_min,omitempty" example:"250"`
+	CcMax      int64    `json:"cc_max,omitempty" bson:"cc_max,omitempty" example:"700"`
+	HpMin      int64    `json:"hp_min,omitempty" bson:"hp_min,omitempty" example:"40"`
+	Deal       string   `json:"deal,omitempty" bson:"deal,omitempty" example:"great,good"`
+	DocsValid  bool     `json:"docs_valid,omitempty" bson:"docs_valid,omitempty" example:"true"`
+	Extras     string   `json:"extras,omitempty" bson:"extras,omitempty" example:"abs,side_cases"`
+	Mode       string   `json:"mode,omitempty" bson:"mode,omitempty" example:"substring"`
+	City       string   `json:"city,omitempty" bson:"city,omitempty" example:"Medellín"`
+	Department string   `json:"department,omitempty" bson:"department,omitempty" example:"Antioquia"`
+	Lat        *float64 `json:"lat,omitempty" bson:"lat,omitempty" example:"6.2442"`
+	Lng        *float64 `json:"lng,omitempty" bson:"lng,omitempty" example:"-75.5812"`
+	RadiusKm   *float64 `json:"radius_km,omitempty" bson:"radius_km,omitempty" example:"20"`
+}
+
+// ToRequest convierte los filtros guardados en la peticion de busqueda equivalente
+func (f SearchFilters) ToRequest() GetAllBikesRequest {
+	return GetAllBikesRequest{
+		Name:       f.Name,
+		Brand:      f.Brand,
+		PriceMin:   f.PriceMin,
+		PriceMax:   f.PriceMax,
+		YearMin:    f.YearMin,
+		YearMax:    f.YearMax,
+		KmMax:      f.KmMax,
+		CcMin:      f.CcMin,
+		CcMax:      f.CcMax,
+		HpMin:      f.HpMin,
+		Deal:       f.Deal,
+		DocsValid:  f.DocsValid,
+		Extras:     f.Extras,
+		Mode:       f.Mode,
+		City:       f.City,
+		Department: f.Department,
+		Lat:        f.Lat,
+		Lng:        f.Lng,
+		RadiusKm:   f.RadiusKm,
+	}
+}
+
+// SavedSearchMatch representa una moto que empezo a cumplir los filtros de una busqueda guardada.
+// El ID crece con cada coincidencia registrada y es el cursor para consultar las siguientes
+type SavedSearchMatch struct {
+	ID        bson.ObjectID `json:"id" bson:"_id,omitempty" swaggertype:"string" example:"6650c0d2f1a2b3c4d5e6f709"`
+	SearchID  string        `json:"search_id" bson:"search_id" example:"6650c0d2f1a2b3c4d5e6f708"`
+	HashByke  string        `json:"hash_byke" bson:"hash_byke" example:"abcd1234abcd"`
+	FullName  string        `json:"full_name" bson:"full_name" example:"Yamaha MT-03"`
+	Price     int           `json:"price" bson:"price" example:"25000000"`
+	MatchedAt int64         `json:"matched_at" bson:"matched_at" example:"1731081212"`
+}
+
+// SavedSearchEvaluation resume una corrida del evaluador de busquedas guardadas
+type SavedSearchEvaluation struct {
+	Evaluated int64 `json:"evaluated"`
+	Matches   int64 `json:"matches"`
+}
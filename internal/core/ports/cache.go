@@ -1,13 +1,21 @@
 package ports
 
+import "time"
+
 type CacheRepository[K comparable, T any] interface {
 	GetCached(key K) (T, bool)
 	SetCached(key K, value T)
+	// SetCachedWithTTL guarda el valor con un tiempo de vida distinto al del cache
+	SetCachedWithTTL(key K, value T, ttl time.Duration)
+	// DeleteCachedFunc elimina las entradas cuya llave cumple la condicion
+	DeleteCachedFunc(match func(key K) bool)
 	ClearCache()
 }
 
 type CacheClient[K comparable, T any] interface {
 	Get(key K) (T, bool)
 	Set(key K, value T)
+	SetWithTTL(key K, value T, ttl time.Duration)
+	DeleteFunc(match func(key K) bool)
 	Clear()
 }
@@ -5,7 +5,24 @@ import "github.com/gin-gonic/gin"
 type ApiHandler interface {
 	GetAllBikesHandler(g *gin.Context)
 	GetBykeHandler(g *gin.Context)
+	GetSimilarBikesHandler(g *gin.Context)
+	GetPriceHistoryHandler(g *gin.Context)
+	CompareBikesHandler(g *gin.Context)
+	GetBikesBatchHandler(g *gin.Context)
+	GetValuationHandler(g *gin.Context)
+	CreateSavedSearchHandler(g *gin.Context)
+	GetSavedSearchMatchesHandler(g *gin.Context)
 	PlaceHolderHandler(g *gin.Context)
+	GetFacetsHandler(g *gin.Context)
+	GetLocationsHandler(g *gin.Context)
+	GetExtraTagsHandler(g *gin.Context)
+	GetBrandsHandler(g *gin.Context)
+	GetBrandModelsHandler(g *gin.Context)
+	GetStatsHandler(g *gin.Context)
+	GetDuplicateClustersHandler(g *gin.Context)
+	CreateBykeHandler(g *gin.Context)
+	UpdateBykeHandler(g *gin.Context)
+	DeleteBykeHandler(g *gin.Context)
 	HealthHandler(g *gin.Context)
 }
 
@@ -17,22 +17,82 @@ type MongoRepository interface {
 	// FindByHash busca una bike por su hash
 	FindByHash(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOneOptions]) (*domain.FullBykeResponse, *errorBikes.WrapperError)
 
+	// FindByHashes busca el detalle de varias bikes en una sola consulta
+	FindByHashes(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.FullBykeResponse, *errorBikes.WrapperError)
+
+	// FindBikes busca las bikes completas que coincidan con el filtro, sin fallar si no hay resultados
+	FindBikes(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.Bike, *errorBikes.WrapperError)
+
 	// FindAll busca todas las bikes que coincidan con el filtro
 	FindAll(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.BykeReponse, *errorBikes.WrapperError)
 
+	// Count cuenta las bikes que coincidan con el filtro
+	Count(ctx context.Context, filter bson.M) (int64, *errorBikes.WrapperError)
+
 	// FindNames busca los nombres de las motos que coincidan con los parametros de busqueda
-	FindNames(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]string, *errorBikes.WrapperError)
+	FindNames(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.BykeName, *errorBikes.WrapperError)
+
+	// FindFacets ejecuta el pipeline de agregación con $facet y retorna los conteos por categoría
+	FindFacets(ctx context.Context, pipeline mongo.Pipeline) (*domain.BikeFacets, *errorBikes.WrapperError)
+
+	// CountBy cuenta las bikes que coincidan con el filtro agrupadas por el valor de un campo,
+	// si el campo es una lista cada elemento se cuenta por separado
+	CountBy(ctx context.Context, filter bson.M, field string) ([]domain.FacetCount, *errorBikes.WrapperError)
+
+	// FindCatalog agrupa las bikes que coincidan con el filtro por el valor de un campo (sin importar mayúsculas),
+	// con la cantidad de bikes y el rango de años de cada valor
+	FindCatalog(ctx context.Context, filter bson.M, field string) ([]domain.CatalogEntry, *errorBikes.WrapperError)
+
+	// EnsureIndexes crea los índices que necesitan las búsquedas si no existen
+	EnsureIndexes(ctx context.Context) *errorBikes.WrapperError
 
 	// Insert inserta una nueva bike en la colección
 	Insert(ctx context.Context, bike *domain.Bike) *errorBikes.WrapperError
 
-	// UpdateByHash actualiza una bike por su hash
+	// UpdateMany actualiza todas las bikes que coincidan con el filtro
+	UpdateMany(ctx context.Context, filter bson.M, update bson.M) *errorBikes.WrapperError
+
+	// UpdateByHash actualiza una bike por su hash, registrando el cambio de precio si lo hay
 	UpdateByHash(ctx context.Context, hash string, update bson.M) *errorBikes.WrapperError
 
+	// UpdateByHashVersion actualiza una bike por su hash solo si está en la versión recibida, e incrementa su versión
+	UpdateByHashVersion(ctx context.Context, hash string, version int, update bson.M) *errorBikes.WrapperError
+
+	// FindPriceHistory busca los cambios de precio de una bike
+	FindPriceHistory(ctx context.Context, hash string) ([]*domain.PriceChange, *errorBikes.WrapperError)
+
 	// DeleteByHash elimina una bike por su hash
 	DeleteByHash(ctx context.Context, hash string) *errorBikes.WrapperError
 }
 
+// StatsRepository define la interfaz para las estadísticas del catálogo
+type StatsRepository interface {
+	// FindStats calcula con agregaciones las publicaciones por día y semana, los precios por marca y año,
+	// la distribución de kilometraje en los rangos de kmBoundaries y las activas e inactivas
+	FindStats(ctx context.Context, filter bson.M, kmBoundaries []int64) (*domain.BikeStats, *errorBikes.WrapperError)
+}
+
+// SavedSearchRepository define la interfaz para las búsquedas guardadas y sus coincidencias
+type SavedSearchRepository interface {
+	// Insert guarda una nueva búsqueda
+	Insert(ctx context.Context, search *domain.SavedSearch) *errorBikes.WrapperError
+
+	// FindByID busca una búsqueda guardada por su id
+	FindByID(ctx context.Context, id string) (*domain.SavedSearch, *errorBikes.WrapperError)
+
+	// FindAll busca las búsquedas guardadas que coincidan con el filtro
+	FindAll(ctx context.Context, filter bson.M) ([]*domain.SavedSearch, *errorBikes.WrapperError)
+
+	// InsertMatches registra las coincidencias, ignorando las que ya existían
+	InsertMatches(ctx context.Context, matches []*domain.SavedSearchMatch) *errorBikes.WrapperError
+
+	// FindMatches busca las coincidencias que cumplan el filtro
+	FindMatches(ctx context.Context, filter bson.M, opts ...options.Lister[options.FindOptions]) ([]*domain.SavedSearchMatch, *errorBikes.WrapperError)
+
+	// EnsureIndexes crea los índices de las búsquedas guardadas y sus coincidencias
+	EnsureIndexes(ctx context.Context) *errorBikes.WrapperError
+}
+
 // MongoClient define la interfaz para el cliente de MongoDB
 // Esta interfaz permite inyección de dependencias siguiendo arquitectura hexagonal
 // y permite que los servicios trabajen con MongoDB sin depender de la implementación específica
@@ -43,9 +103,21 @@ type MongoClient interface {
 	// FindOne busca un solo documento que coincida con el filtro
 	FindOne(ctx context.Context, collectionName string, filter bson.M, opts ...options.Lister[options.FindOneOptions]) *mongo.SingleResult
 
+	// CountDocuments cuenta los documentos que coincidan con el filtro
+	CountDocuments(ctx context.Context, collectionName string, filter bson.M, opts ...options.Lister[options.CountOptions]) (int64, error)
+
+	// Aggregate ejecuta un pipeline de agregación sobre la colección
+	Aggregate(ctx context.Context, collectionName string, pipeline interface{}, opts ...options.Lister[options.AggregateOptions]) (*mongo.Cursor, error)
+
 	// InsertOne inserta un documento en la colección
 	InsertOne(ctx context.Context, collectionName string, document interface{}) (*mongo.InsertOneResult, error)
 
+	// InsertMany inserta varios documentos en la colección
+	InsertMany(ctx context.Context, collectionName string, documents []interface{}, opts ...options.Lister[options.InsertManyOptions]) (*mongo.InsertManyResult, error)
+
+	// UpdateMany actualiza todos los documentos que coincidan con el filtro
+	UpdateMany(ctx context.Context, collectionName string, filter bson.M, update bson.M, opts ...options.Lister[options.UpdateManyOptions]) (*mongo.UpdateResult, error)
+
 	// UpdateOne actualiza un documento que coincida con el filtro
 	UpdateOne(ctx context.Context, collectionName string, filter bson.M, update bson.M, opts ...options.Lister[options.UpdateOneOptions]) (*mongo.UpdateResult, error)
 
@@ -53,7 +125,7 @@ type MongoClient interface {
 	DeleteOne(ctx context.Context, collectionName string, filter bson.M, opts ...options.Lister[options.DeleteOneOptions]) (*mongo.DeleteResult, error)
 
 	// FindOneAndUpdate encuentra y actualiza un documento
-	FindOneAndUpdate(ctx context.Context, collectionName string, filter bson.M, update bson.M, opts ...options.Lister[options.FindOneAndUpdateOptions]) *mongo.SingleResult
+	FindOneAndUpdate(ctx context.Context, collectionName string, filter bson.M, update interface{}, opts ...options.Lister[options.FindOneAndUpdateOptions]) *mongo.SingleResult
 
 	// GetCollection obtiene una referencia a la colección
 	GetCollection(collectionName string) *mongo.Collection
@@ -9,6 +9,7 @@ import (
 
 type R2Repository interface {
 	GetPresignedURL(ctx context.Context, objectKey string, expires time.Duration) (string, *errorBikes.WrapperError)
+	GetObject(ctx context.Context, objectKey string) ([]byte, *errorBikes.WrapperError)
 	GetBucketName() string
 }
 
@@ -16,6 +17,9 @@ type R2Client interface {
 	// PresignGetObject genera una URL prefirmada para descargar un objeto del bucket
 	PresignGetObject(ctx context.Context, objectKey string, expires time.Duration) (string, error)
 
+	// GetObject descarga el contenido de un objeto del bucket
+	GetObject(ctx context.Context, objectKey string) ([]byte, error)
+
 	// GetBucketName retorna el nombre del bucket configurado
 	GetBucketName() string
 }
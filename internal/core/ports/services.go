@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
 )
 
 type GetAllBikes interface {
@@ -11,9 +12,88 @@ type GetAllBikes interface {
 }
 
 type GetByke interface {
-	Execute(ctx context.Context, requestByke domain.SearchBykeRequest, pathRequest string) (*domain.GetBykeResponseSuccess, *domain.ResponseHttpError)
+	Execute(ctx context.Context, requestByke domain.SearchBykeRequest) (*domain.GetBykeResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetSimilarBikes interface {
+	Execute(ctx context.Context, request domain.SimilarBikesRequest, pathRequest string) (*domain.SimilarBikesResponseSuccess, *domain.ResponseHttpError)
+}
+
+type CompareBikes interface {
+	Execute(ctx context.Context, request domain.CompareBikesRequest, pathRequest string) (*domain.CompareResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetBikesBatch interface {
+	Execute(ctx context.Context, request domain.BatchBikesRequest) (*domain.BatchBikesResponseSuccess, *domain.ResponseHttpError)
+}
+
+type CreateSavedSearch interface {
+	Execute(ctx context.Context, request domain.CreateSavedSearchRequest) (*domain.SavedSearchResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetSavedSearchMatches interface {
+	Execute(ctx context.Context, request domain.SavedSearchMatchesRequest) (*domain.SavedSearchMatchesResponseSuccess, *domain.ResponseHttpError)
+}
+
+type EvaluateSavedSearches interface {
+	Execute(ctx context.Context) (*domain.SavedSearchEvaluation, *errorBikes.WrapperError)
+	Run(ctx context.Context)
+}
+
+type GetPriceHistory interface {
+	Execute(ctx context.Context, requestByke domain.SearchBykeRequest) (*domain.PriceHistoryResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetValuation interface {
+	Execute(ctx context.Context, request domain.ValuationRequest, pathRequest string) (*domain.ValuationResponseSuccess, *domain.ResponseHttpError)
+}
+
+type CreateByke interface {
+	Execute(ctx context.Context, request domain.CreateBykeRequest) (*domain.CreateBykeResponseSuccess, *domain.ResponseHttpError)
+}
+
+type UpdateByke interface {
+	Execute(ctx context.Context, hash string, version int, request domain.UpdateBykeRequest) (*domain.UpdateBykeResponseSuccess, *domain.ResponseHttpError)
+}
+
+type DeleteByke interface {
+	Execute(ctx context.Context, hash string) *domain.ResponseHttpError
+}
+
+type GetDuplicateClusters interface {
+	Execute(ctx context.Context) (*domain.DuplicateClustersResponseSuccess, *domain.ResponseHttpError)
 }
 
 type PlaceHolder interface {
 	Execute(ctx context.Context, requestPlaceHolder domain.PlaceHolderRequest) (*domain.PlaceHolderResponseSuccess, *domain.ResponseHttpError)
+	Refresh(ctx context.Context)
+}
+
+type GetFacets interface {
+	Execute(ctx context.Context, request domain.GetAllBikesRequest, pathRequest string) (*domain.FacetsResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetBrands interface {
+	Execute(ctx context.Context) (*domain.BrandsResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetBrandModels interface {
+	Execute(ctx context.Context, request domain.BrandModelsRequest) (*domain.ModelsResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetStats interface {
+	Execute(ctx context.Context, request domain.StatsRequest, pathRequest string) (*domain.StatsResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetExtraTags interface {
+	Execute(ctx context.Context) (*domain.ExtraTagsResponseSuccess, *domain.ResponseHttpError)
+}
+
+type GetLocations interface {
+	Execute(ctx context.Context) (*domain.LocationsResponseSuccess, *domain.ResponseHttpError)
+}
+
+// Backfill completa campos derivados en las motos ya publicadas
+type Backfill interface {
+	Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError)
 }
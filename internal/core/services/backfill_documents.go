@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/paperwork"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// documentExpiringDays es la cantidad de dias antes del vencimiento en que un documento se marca como expiring
+const documentExpiringDays = 30
+
+type backfillDocuments struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewBackfillDocuments(mongoRepository ports.MongoRepository) *backfillDocuments {
+	return &backfillDocuments{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute interpreta las fechas de SOAT y tecnico-mecanica de todas las motos y guarda su vencimiento
+// como timestamp para filtrar en /search, solo actualiza las motos cuyos valores cambiaron
+func (s *backfillDocuments) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	projection := bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "date_soat", Value: 1},
+		{Key: "date_tecnico", Value: 1},
+		{Key: "soat_expires_at", Value: 1},
+		{Key: "tecnico_expires_at", Value: 1},
+	}
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.BackfillResult{}
+	for _, bike := range bikes {
+		result.Processed++
+
+		soat, tecnico := documentExpiry(bike.DateSoat), documentExpiry(bike.DateTecnico)
+		if sameInt(soat, bike.SoatExpiresAt) && sameInt(tecnico, bike.TecnicoExpiresAt) {
+			result.Skipped++
+			continue
+		}
+
+		update := bson.M{"soat_expires_at": soat, "tecnico_expires_at": tecnico}
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, update); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
+
+// documentExpiry retorna el vencimiento de un documento como timestamp, nil si la fecha no se pudo interpretar
+func documentExpiry(value string) *int64 {
+	expiry, ok := paperwork.ParseExpiry(value)
+	if !ok {
+		return nil
+	}
+
+	unix := expiry.Unix()
+	return &unix
+}
+
+// documentStatus califica un documento a partir de su fecha de vencimiento y retorna los dias que le quedan
+func documentStatus(value string, now time.Time) (string, *int) {
+	expiry, ok := paperwork.ParseExpiry(value)
+	if !ok {
+		if paperwork.IsExpiredText(value) {
+			return domain.DocumentExpired, nil
+		}
+		return domain.DocumentUnknown, nil
+	}
+
+	days := paperwork.DaysUntil(expiry, now)
+	switch {
+	case days < 0:
+		return domain.DocumentExpired, &days
+	case days <= documentExpiringDays:
+		return domain.DocumentExpiring, &days
+	default:
+		return domain.DocumentValid, &days
+	}
+}
+
+// setDocumentStatus completa el estado del SOAT y la tecnico-mecanica de la moto
+func setDocumentStatus(byke *domain.FullBykeResponse, now time.Time) {
+	byke.SoatStatus, byke.SoatDaysLeft = documentStatus(byke.DateSoat, now)
+	byke.TecnicoStatus, byke.TecnicoDaysLeft = documentStatus(byke.DateTecnico, now)
+}
+
+// withDocumentStatus retorna una copia de la moto con el estado de sus documentos a la fecha indicada.
+// Los dias restantes cambian cada dia, por eso el estado se calcula al responder y no se guarda en cache
+func withDocumentStatus(byke *domain.FullBykeResponse, now time.Time) *domain.FullBykeResponse {
+	copied := *byke
+	setDocumentStatus(&copied, now)
+	return &copied
+}
+
+func sameInt(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
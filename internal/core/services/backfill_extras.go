@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/extras"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type backfillExtras struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewBackfillExtras(mongoRepository ports.MongoRepository) *backfillExtras {
+	return &backfillExtras{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute convierte los extras de todas las motos en etiquetas canonicas y las guarda junto a los extras
+// originales, solo actualiza las motos cuyas etiquetas cambiaron
+func (s *backfillExtras) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	projection := bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "extras", Value: 1},
+		{Key: "extras_tags", Value: 1},
+	}
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.BackfillResult{}
+	for _, bike := range bikes {
+		result.Processed++
+
+		tags := extras.Canonical(bike.Extras)
+		if slices.Equal(tags, bike.ExtrasTags) {
+			result.Skipped++
+			continue
+		}
+
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, bson.M{"extras_tags": tags}); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
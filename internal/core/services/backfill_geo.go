@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// unresolvedSampleSize es cuantas ubicaciones no reconocidas se muestran en el log
+const unresolvedSampleSize = 10
+
+type backfillGeo struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewBackfillGeo(mongoRepository ports.MongoRepository) *backfillGeo {
+	return &backfillGeo{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute completa el campo geo de las motos que aun no lo tienen a partir de su ubicacion
+// o, si no se reconoce, de la ciudad de registro. Las motos que no se pueden ubicar quedan en Skipped
+// y se reportan con sus ubicaciones mas frecuentes para completar los alias del catalogo
+func (s *backfillGeo) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	filter := bson.M{"geo": bson.M{"$exists": false}}
+	projection := bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "location", Value: 1},
+		{Key: "city_register", Value: 1},
+	}
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, filter, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.BackfillResult{}
+	unresolved := map[string]int{}
+	for _, bike := range bikes {
+		result.Processed++
+
+		coordinates, ok := location.Locate(bike.Location)
+		if !ok {
+			coordinates, ok = location.Locate(bike.CityRegister)
+		}
+
+		if !ok {
+			unresolved[bike.Location]++
+			result.Skipped++
+			continue
+		}
+
+		update := bson.M{"geo": domain.NewGeoPoint(coordinates.Lat, coordinates.Lng)}
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, update); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+
+	if result.Skipped > 0 {
+		log.Printf("backfill geo: %d of %d bikes could not be resolved to a municipality, most frequent locations: %s",
+			result.Skipped, result.Processed, mostFrequent(unresolved, unresolvedSampleSize))
+	}
+
+	return result, nil
+}
+
+// mostFrequent retorna los limit textos que mas se repiten con su cantidad, del mas al menos frecuente
+func mostFrequent(counts map[string]int, limit int) string {
+	values := make([]string, 0, len(counts))
+	for value := range counts {
+		values = append(values, value)
+	}
+
+	sort.Slice(values, func(i, j int) bool {
+		if counts[values[i]] != counts[values[j]] {
+			return counts[values[i]] > counts[values[j]]
+		}
+		return values[i] < values[j]
+	})
+
+	if len(values) > limit {
+		values = values[:limit]
+	}
+
+	samples := make([]string, 0, len(values))
+	for _, value := range values {
+		samples = append(samples, fmt.Sprintf("%q (%d)", value, counts[value]))
+	}
+
+	return strings.Join(samples, ", ")
+}
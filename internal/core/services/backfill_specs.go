@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/specs"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type backfillSpecs struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewBackfillSpecs(mongoRepository ports.MongoRepository) *backfillSpecs {
+	return &backfillSpecs{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute interpreta cilindraje, potencia, torque y peso de todas las motos y guarda los valores
+// numericos junto a los textos originales, solo actualiza las motos cuyos valores cambiaron
+func (s *backfillSpecs) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	projection := bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "cylinder", Value: 1},
+		{Key: "horse_power", Value: 1},
+		{Key: "torque", Value: 1},
+		{Key: "weight", Value: 1},
+		{Key: "cylinder_cc", Value: 1},
+		{Key: "horse_power_hp", Value: 1},
+		{Key: "torque_nm", Value: 1},
+		{Key: "weight_kg", Value: 1},
+	}
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{}, options.Find().SetProjection(projection))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.BackfillResult{}
+	for _, bike := range bikes {
+		result.Processed++
+
+		values := specs.Parse(bike.Cylinder, bike.HorsePower, bike.Torque, bike.Weight)
+		if sameFloat(values.CylinderCc, bike.CylinderCc) && sameFloat(values.HorsePowerHp, bike.HorsePowerHp) &&
+			sameFloat(values.TorqueNm, bike.TorqueNm) && sameFloat(values.WeightKg, bike.WeightKg) {
+			result.Skipped++
+			continue
+		}
+
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, specsUpdate(values)); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
+
+// specsUpdate arma los campos numericos de las especificaciones a guardar en la moto
+func specsUpdate(values specs.Values) bson.M {
+	return bson.M{
+		"cylinder_cc":    values.CylinderCc,
+		"horse_power_hp": values.HorsePowerHp,
+		"torque_nm":      values.TorqueNm,
+		"weight_kg":      values.WeightKg,
+	}
+}
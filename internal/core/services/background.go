@@ -0,0 +1,52 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+)
+
+// BackfillRefreshTime es cada cuanto se completan los campos derivados de las motos en segundo plano
+const BackfillRefreshTime = time.Hour
+
+// BackfillJob relaciona un proceso de completado con el nombre que se usa en los logs
+type BackfillJob struct {
+	Name string
+	Job  ports.Backfill
+}
+
+// RunEvery ejecuta run al iniciar y luego cada interval hasta que se cancele ctx
+func RunEvery(ctx context.Context, interval time.Duration, run func(ctx context.Context)) {
+	run(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			run(ctx)
+		}
+	}
+}
+
+// RunBackfills retorna una tarea que ejecuta los procesos en orden y registra el resultado de cada uno
+func RunBackfills(jobs ...BackfillJob) func(ctx context.Context) {
+	return func(ctx context.Context) {
+		for _, job := range jobs {
+			result, err := job.Job.Execute(ctx)
+			if err != nil {
+				log.Printf("error running backfill %s: %v", job.Name, err.Message)
+				continue
+			}
+
+			if result.Updated > 0 || result.Skipped > 0 {
+				log.Printf("backfill %s: processed=%d updated=%d skipped=%d", job.Name, result.Processed, result.Updated, result.Skipped)
+			}
+		}
+	}
+}
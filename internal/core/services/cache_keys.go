@@ -0,0 +1,49 @@
+package services
+
+import "github.com/Bikes2Road/bikes-compass/utils/text"
+
+// Llaves y prefijos de las llaves de cache de cada servicio. Las que dependen de la peticion
+// se forman con el prefijo del servicio seguido del RequestURI
+const (
+	bykeCachePrefix         = "byke|"
+	priceHistoryCachePrefix = "price_history|"
+	searchCachePrefix       = "search|"
+	facetsCachePrefix       = "facets|"
+	similarCachePrefix      = "similar|"
+	compareCachePrefix      = "compare|"
+	valuationCachePrefix    = "valuation|"
+	statsCachePrefix        = "stats|"
+	brandModelsCachePrefix  = "brands|"
+	brandsCacheKey          = "brands"
+	locationsCacheKey       = "locations"
+	extraTagsCacheKey       = "extras"
+)
+
+// listingsCachePrefixes son los prefijos de las respuestas que dependen de varias publicaciones
+var listingsCachePrefixes = []string{
+	searchCachePrefix,
+	facetsCachePrefix,
+	similarCachePrefix,
+	compareCachePrefix,
+	valuationCachePrefix,
+	statsCachePrefix,
+	brandModelsCachePrefix,
+}
+
+// listingsCacheKeys son las llaves de los catalogos calculados con todas las publicaciones
+var listingsCacheKeys = []string{brandsCacheKey, locationsCacheKey, extraTagsCacheKey}
+
+// bykeCacheKey retorna la llave de cache del detalle de una moto
+func bykeCacheKey(hash string) string {
+	return bykeCachePrefix + hash
+}
+
+// priceHistoryCacheKey retorna la llave de cache del historial de precios de una moto
+func priceHistoryCacheKey(hash string) string {
+	return priceHistoryCachePrefix + hash
+}
+
+// brandModelsCacheKey retorna la llave de cache de los modelos de una marca
+func brandModelsCacheKey(brand string) string {
+	return brandModelsCachePrefix + text.Fold(brand)
+}
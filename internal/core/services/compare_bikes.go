@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/specs"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Direccion del mejor valor de cada fila de la comparacion
+const (
+	betterMax = "max"
+	betterMin = "min"
+)
+
+// comparisonSpec define como se lee y se compara una especificacion.
+// Las filas sin better solo se muestran, ninguno de sus valores se marca como mejor
+type comparisonSpec struct {
+	name   string
+	unit   string
+	better string
+	value  func(byke *domain.FullBykeResponse) (string, float64, bool)
+}
+
+// comparisonSpecs son las filas de la comparacion, en el orden en que se muestran
+var comparisonSpecs = []comparisonSpec{
+	{name: "cylinder", unit: "cc", better: betterMax, value: parsedSpec(func(b *domain.FullBykeResponse) string { return b.Cylinder }, specs.Cylinder)},
+	{name: "horse_power", unit: "hp", better: betterMax, value: parsedSpec(func(b *domain.FullBykeResponse) string { return b.HorsePower }, specs.HorsePower)},
+	{name: "torque", unit: "Nm", better: betterMax, value: parsedSpec(func(b *domain.FullBykeResponse) string { return b.Torque }, specs.Torque)},
+	{name: "weight", unit: "kg", better: betterMin, value: parsedSpec(func(b *domain.FullBykeResponse) string { return b.Weight }, specs.Weight)},
+	{name: "km", unit: "km", better: betterMin, value: intSpec(func(b *domain.FullBykeResponse) int { return b.Kilometers })},
+	{name: "price", unit: "COP", better: betterMin, value: intSpec(func(b *domain.FullBykeResponse) int { return b.Price })},
+	{name: "year_model", unit: "year", better: betterMax, value: intSpec(func(b *domain.FullBykeResponse) int { return b.YearModel })},
+	{name: "extras", unit: "count", value: func(b *domain.FullBykeResponse) (string, float64, bool) {
+		return strings.Join(b.Extras, ", "), float64(len(b.Extras)), true
+	}},
+}
+
+type compareBikes struct {
+	mongoRepository ports.MongoRepository
+	r2Repository    ports.R2Repository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewCompareBikes(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) *compareBikes {
+	return &compareBikes{
+		mongoRepository: mongoRepository,
+		r2Repository:    r2Repository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+func (s *compareBikes) Execute(ctx context.Context, requestByke domain.CompareBikesRequest, pathRequest string) (*domain.CompareResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := compareCachePrefix + pathRequest
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.CompareResponseSuccess); ok {
+			return comparisonAt(resp, time.Now()), nil
+		}
+	}
+
+	expireTime := 15 * 60 * time.Second
+
+	found, err := s.mongoRepository.FindByHashes(ctx, bson.M{"hash_byke": bson.M{"$in": requestByke.Hashes}}, options.Find().SetProjection(fullBykeProjection))
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	if len(found) == 0 {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorBikesNotFound, nil)
+	}
+
+	byHash := make(map[string]*domain.FullBykeResponse, len(found))
+	for _, byke := range found {
+		byHash[byke.HashByke] = byke
+	}
+
+	// Keep the order of the request so the columns match what the client asked for
+	bikes := []*domain.FullBykeResponse{}
+	missing := []string{}
+	for _, hash := range requestByke.Hashes {
+		byke, ok := byHash[hash]
+		if !ok {
+			missing = append(missing, hash)
+			continue
+		}
+		bikes = append(bikes, byke)
+	}
+
+	// Only the cover photos are needed side by side
+	var wg sync.WaitGroup
+	for _, byke := range bikes {
+		if len(byke.Photos) > 1 {
+			byke.Photos = byke.Photos[:1]
+		}
+		wg.Add(1)
+		go func(byke *domain.FullBykeResponse) {
+			defer wg.Done()
+			presignPhotos(ctx, s.r2Repository, byke.Photos, expireTime)
+		}(byke)
+	}
+	wg.Wait()
+
+	rows := make([]domain.ComparisonRow, 0, len(comparisonSpecs))
+	for _, spec := range comparisonSpecs {
+		rows = append(rows, compareRow(spec, bikes))
+	}
+
+	response := &domain.CompareResponseSuccess{
+		Success: true,
+		Data: &domain.BikeComparison{
+			Bikes:   bikes,
+			Rows:    rows,
+			Missing: missing,
+		},
+	}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return comparisonAt(response, time.Now()), nil
+}
+
+// comparisonAt copia la comparacion guardada en cache con el estado de los documentos a la fecha indicada
+func comparisonAt(response *domain.CompareResponseSuccess, now time.Time) *domain.CompareResponseSuccess {
+	comparison := *response.Data
+	comparison.Bikes = make([]*domain.FullBykeResponse, 0, len(response.Data.Bikes))
+	for _, byke := range response.Data.Bikes {
+		comparison.Bikes = append(comparison.Bikes, withDocumentStatus(byke, now))
+	}
+	return &domain.CompareResponseSuccess{Success: response.Success, Data: &comparison}
+}
+
+// compareRow arma la fila de una especificacion y marca como mejores los valores que empatan en el mejor.
+// Si la fila no tiene better o menos de dos motos tienen el valor no hay contra que comparar y ninguno se marca
+func compareRow(spec comparisonSpec, bikes []*domain.FullBykeResponse) domain.ComparisonRow {
+	row := domain.ComparisonRow{Spec: spec.name, Unit: spec.unit, Better: spec.better, Values: make([]domain.ComparisonValue, 0, len(bikes))}
+
+	var best *float64
+	parsed := 0
+	for _, byke := range bikes {
+		raw, number, ok := spec.value(byke)
+		value := domain.ComparisonValue{HashByke: byke.HashByke, Raw: raw}
+		if ok {
+			value.Value = &number
+			parsed++
+			if best == nil || (spec.better == betterMax && number > *best) || (spec.better == betterMin && number < *best) {
+				best = &number
+			}
+		}
+		row.Values = append(row.Values, value)
+	}
+
+	if spec.better == "" || parsed < 2 {
+		return row
+	}
+
+	for i := range row.Values {
+		if row.Values[i].Value != nil && *row.Values[i].Value == *best {
+			row.Values[i].Best = true
+		}
+	}
+
+	return row
+}
+
+// parsedSpec lee una especificacion de texto y la convierte con el parser de su unidad
+func parsedSpec(field func(*domain.FullBykeResponse) string, parse func(string) (float64, bool)) func(*domain.FullBykeResponse) (string, float64, bool) {
+	return func(byke *domain.FullBykeResponse) (string, float64, bool) {
+		raw := field(byke)
+		value, ok := parse(raw)
+		return raw, value, ok
+	}
+}
+
+// intSpec lee una especificacion numerica, 0 se toma como no informado
+func intSpec(field func(*domain.FullBykeResponse) int) func(*domain.FullBykeResponse) (string, float64, bool) {
+	return func(byke *domain.FullBykeResponse) (string, float64, bool) {
+		value := field(byke)
+		if value <= 0 {
+			return "", 0, false
+		}
+		return strconv.Itoa(value), float64(value), true
+	}
+}
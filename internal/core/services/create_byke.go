@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/extras"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+	"github.com/Bikes2Road/bikes-compass/utils/specs"
+)
+
+const (
+	// hashBykeAlphabet son los caracteres del hash_byke, minusculas y numeros
+	hashBykeAlphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	hashBykeLength   = 12
+	// hashBykeAttempts es la cantidad de hashes que se prueban antes de fallar por colisiones con el indice unico
+	hashBykeAttempts = 5
+)
+
+type createByke struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewCreateByke(mongoRepository ports.MongoRepository) *createByke {
+	return &createByke{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute crea la publicacion con un hash_byke nuevo, pendiente de revision (reviewed=false)
+// y con los campos derivados (specs, etiquetas, vencimientos y ubicacion) ya calculados
+func (s *createByke) Execute(ctx context.Context, request domain.CreateBykeRequest) (*domain.CreateBykeResponseSuccess, *domain.ResponseHttpError) {
+	now := time.Now().Unix()
+
+	bike := &domain.Bike{
+		Ref:           request.Ref,
+		Brand:         strings.TrimSpace(request.Brand),
+		Model:         strings.TrimSpace(request.Model),
+		FullName:      strings.TrimSpace(request.FullName),
+		YearModel:     request.YearModel,
+		Cylinder:      request.Cylinder,
+		Engine:        request.Engine,
+		HorsePower:    request.HorsePower,
+		Torque:        request.Torque,
+		Weight:        request.Weight,
+		Kilometers:    *request.Kilometers,
+		Price:         request.Price,
+		Location:      strings.TrimSpace(request.Location),
+		CityRegister:  request.CityRegister,
+		Extras:        request.Extras,
+		Description:   request.Description,
+		DateFound:     int(now),
+		DatePublish:   request.DatePublish,
+		DateSoat:      request.DateSoat,
+		DateTecnico:   request.DateTecnico,
+		PageInstagram: request.PageInstagram,
+		UrlPost:       request.UrlPost,
+		Photos:        request.Photos,
+		Active:        request.Active == nil || *request.Active,
+		Reviewed:      false,
+		Version:       1,
+	}
+
+	if bike.DatePublish == 0 {
+		bike.DatePublish = int(now)
+	}
+
+	if bike.Photos == nil {
+		bike.Photos = [][]domain.Photo{}
+	}
+
+	setDerivedFields(bike)
+
+	if errResp := s.insertWithNewHash(ctx, bike); errResp != nil {
+		return nil, errResp
+	}
+
+	return &domain.CreateBykeResponseSuccess{Success: true, Data: bike}, nil
+}
+
+// insertWithNewHash inserta la bike con un hash_byke aleatorio y, si el indice unico lo rechaza
+// porque ya existe, lo vuelve a intentar con otro
+func (s *createByke) insertWithNewHash(ctx context.Context, bike *domain.Bike) *domain.ResponseHttpError {
+	for attempt := 0; attempt < hashBykeAttempts; attempt++ {
+		hash, err := newHashByke()
+		if err != nil {
+			return errorBikes.MapErrorResponse(errorBikes.ErrorUnexpected, err)
+		}
+		bike.HashByke = hash
+
+		errInsert := s.mongoRepository.Insert(ctx, bike)
+		if errInsert == nil {
+			return nil
+		}
+		if errInsert.Type != errorBikes.ErrorDuplicateByke {
+			return errorBikes.MapErrorResponse(errInsert.Type, errInsert.Message)
+		}
+	}
+
+	err := fmt.Errorf("could not generate a unique hash_byke after %d attempts", hashBykeAttempts)
+	return errorBikes.MapErrorResponse(errorBikes.ErrorUnexpected, err)
+}
+
+// newHashByke genera un hash_byke aleatorio de 12 caracteres alfanumericos
+func newHashByke() (string, error) {
+	hash := make([]byte, hashBykeLength)
+	max := big.NewInt(int64(len(hashBykeAlphabet)))
+	for i := range hash {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		hash[i] = hashBykeAlphabet[n.Int64()]
+	}
+	return string(hash), nil
+}
+
+// setDerivedFields calcula los campos que las busquedas usan a partir de los textos de la publicacion,
+// los mismos que completan los backfills de specs, extras, documents y geo
+func setDerivedFields(bike *domain.Bike) {
+	values := specs.Parse(bike.Cylinder, bike.HorsePower, bike.Torque, bike.Weight)
+	bike.CylinderCc, bike.HorsePowerHp, bike.TorqueNm, bike.WeightKg = values.CylinderCc, values.HorsePowerHp, values.TorqueNm, values.WeightKg
+
+	bike.ExtrasTags = extras.Canonical(bike.Extras)
+	bike.SoatExpiresAt = documentExpiry(bike.DateSoat)
+	bike.TecnicoExpiresAt = documentExpiry(bike.DateTecnico)
+
+	bike.Geo = nil
+	coordinates, ok := location.Locate(bike.Location)
+	if !ok {
+		coordinates, ok = location.Locate(bike.CityRegister)
+	}
+	if ok {
+		bike.Geo = domain.NewGeoPoint(coordinates.Lat, coordinates.Lng)
+	}
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type deleteByke struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewDeleteByke(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *deleteByke {
+	return &deleteByke{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute elimina la publicacion y limpia su cache. Las copias que la tenian como canonica
+// vuelven a mostrarse en /search hasta la siguiente deteccion de duplicados
+func (s *deleteByke) Execute(ctx context.Context, hash string) *domain.ResponseHttpError {
+	if err := s.mongoRepository.DeleteByHash(ctx, hash); err != nil {
+		return errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	if err := s.mongoRepository.UpdateMany(ctx, bson.M{"duplicate_of": hash}, bson.M{"duplicate_of": nil}); err != nil {
+		return errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	invalidateBykeCache(s.cacheRepository, hash)
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"context"
+	"log"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+	"github.com/Bikes2Road/bikes-compass/utils/photohash"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Peso de cada señal en la probabilidad de que dos publicaciones sean la misma moto. Las fotos son
+// obligatorias: sin fotos parecidas el puntaje es 0, y con ellas hace falta ademas km, precio o municipio
+const (
+	duplicateKmWeight       = 0.3
+	duplicatePriceWeight    = 0.3
+	duplicateLocationWeight = 0.2
+	duplicatePhotosWeight   = 0.2
+	// duplicateThreshold es el puntaje a partir del cual dos publicaciones se agrupan
+	duplicateThreshold = 0.7
+)
+
+const (
+	duplicateKmTolerance    = 0.05
+	duplicateMinKmDiff      = 1000
+	duplicatePriceTolerance = 0.10
+	duplicatePhotosMin      = 0.5
+	// duplicatePhotoDistance es la maxima cantidad de bits distintos entre los dHash de dos copias de una foto
+	duplicatePhotoDistance = 10
+)
+
+var nonAlphanumericName = regexp.MustCompile(`[^a-z0-9]+`)
+
+type detectDuplicates struct {
+	mongoRepository ports.MongoRepository
+	r2Repository    ports.R2Repository
+}
+
+// NewDetectDuplicates crea el detector de publicaciones duplicadas
+func NewDetectDuplicates(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository) *detectDuplicates {
+	return &detectDuplicates{
+		mongoRepository: mongoRepository,
+		r2Repository:    r2Repository,
+	}
+}
+
+// Execute agrupa las publicaciones activas que probablemente son la misma moto y guarda en duplicate_of
+// el hash de la publicacion canonica (la mas antigua) en las demas del grupo
+func (s *detectDuplicates) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	findOpts := options.Find().SetProjection(bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "full_name", Value: 1},
+		{Key: "year_model", Value: 1},
+		{Key: "km", Value: 1},
+		{Key: "price", Value: 1},
+		{Key: "location", Value: 1},
+		{Key: "date_publish", Value: 1},
+		{Key: "photos", Value: 1},
+		{Key: "photo_hashes", Value: 1},
+		{Key: "duplicate_of", Value: 1},
+	})
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{"active": true, "reviewed": true}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := duplicateBlocks(bikes)
+
+	// Only the listings that share name and year with another one need their photos hashed
+	photoHashes := make([][]uint64, len(bikes))
+	for _, members := range blocks {
+		if len(members) < 2 {
+			continue
+		}
+		for _, i := range members {
+			if photoHashes[i], err = s.photoHashes(ctx, bikes[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	canonical := clusterDuplicates(bikes, blocks, photoHashes)
+
+	result := &domain.BackfillResult{}
+	for _, bike := range bikes {
+		result.Processed++
+
+		duplicateOf := canonical[bike.HashByke]
+		if duplicateOf == bike.DuplicateOf {
+			result.Skipped++
+			continue
+		}
+
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, bson.M{"duplicate_of": nilIfEmpty(duplicateOf)}); err != nil {
+			return result, err
+		}
+		result.Updated++
+	}
+
+	return result, nil
+}
+
+// photoHashes retorna el dHash de cada foto de la moto. Los hashes se guardan en photo_hashes por llave de R2
+// y solo se descargan las fotos nuevas; una foto que no se pudo decodificar queda vacia para no reintentarla
+func (s *detectDuplicates) photoHashes(ctx context.Context, bike *domain.Bike) ([]uint64, *errorBikes.WrapperError) {
+	stored := map[string]string{}
+	changed := false
+	for _, group := range bike.Photos {
+		for _, photo := range group {
+			if photo.Key == "" {
+				continue
+			}
+			if value, ok := bike.PhotoHashes[photo.Key]; ok {
+				stored[photo.Key] = value
+				continue
+			}
+
+			content, err := s.r2Repository.GetObject(ctx, photo.Key)
+			if err != nil {
+				// Retried on the next run
+				log.Printf("error downloading photo %s of byke %s: %v", photo.Key, bike.HashByke, err.Message)
+				continue
+			}
+
+			stored[photo.Key] = ""
+			if hash, errHash := photohash.DHash(content); errHash == nil {
+				stored[photo.Key] = photohash.Format(hash)
+			}
+			changed = true
+		}
+	}
+
+	if changed || len(stored) != len(bike.PhotoHashes) {
+		if err := s.mongoRepository.UpdateByHash(ctx, bike.HashByke, bson.M{"photo_hashes": stored}); err != nil {
+			return nil, err
+		}
+	}
+
+	hashes := make([]uint64, 0, len(stored))
+	for _, value := range stored {
+		if hash, ok := photohash.Parse(value); ok {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes, nil
+}
+
+// duplicateBlocks agrupa los indices de las publicaciones que pueden ser duplicadas (ver duplicateKey)
+func duplicateBlocks(bikes []*domain.Bike) map[string][]int {
+	blocks := map[string][]int{}
+	for i, bike := range bikes {
+		key := duplicateKey(bike)
+		blocks[key] = append(blocks[key], i)
+	}
+	return blocks
+}
+
+// clusterDuplicates agrupa las publicaciones de cada bloque con enlace completo: recorriendolas de la mas
+// antigua a la mas nueva, una publicacion entra al primer grupo con el que supera el umbral contra todos sus
+// miembros, asi A~B y B~C no juntan A y C si no se parecen. Retorna para cada publicacion no canonica
+// el hash de la canonica (la mas antigua) de su grupo
+func clusterDuplicates(bikes []*domain.Bike, blocks map[string][]int, photoHashes [][]uint64) map[string]string {
+	municipalities := make([]string, len(bikes))
+	for _, members := range blocks {
+		if len(members) < 2 {
+			continue
+		}
+		for _, i := range members {
+			if match := location.Normalize(bikes[i].Location); match.Municipality != nil {
+				municipalities[i] = match.Municipality.Code
+			}
+		}
+	}
+
+	canonical := map[string]string{}
+	for _, members := range blocks {
+		if len(members) < 2 {
+			continue
+		}
+
+		// The oldest listing is the canonical one, ties broken by hash to keep it stable
+		sort.Slice(members, func(a, b int) bool {
+			first, second := bikes[members[a]], bikes[members[b]]
+			if first.DatePublish != second.DatePublish {
+				return first.DatePublish < second.DatePublish
+			}
+			return first.HashByke < second.HashByke
+		})
+
+		groups := [][]int{}
+		for _, i := range members {
+			joined := false
+			for g, group := range groups {
+				if matchesAll(group, func(j int) bool {
+					return duplicateScore(bikes[i], bikes[j], municipalities[i], municipalities[j], photoHashes[i], photoHashes[j]) >= duplicateThreshold
+				}) {
+					groups[g] = append(group, i)
+					joined = true
+					break
+				}
+			}
+			if !joined {
+				groups = append(groups, []int{i})
+			}
+		}
+
+		for _, group := range groups {
+			for _, member := range group[1:] {
+				canonical[bikes[member].HashByke] = bikes[group[0]].HashByke
+			}
+		}
+	}
+
+	return canonical
+}
+
+func matchesAll(group []int, matches func(j int) bool) bool {
+	for _, j := range group {
+		if !matches(j) {
+			return false
+		}
+	}
+	return true
+}
+
+// duplicateKey agrupa las publicaciones que pueden ser duplicadas: mismo nombre normalizado y mismo año
+func duplicateKey(bike *domain.Bike) string {
+	return nonAlphanumericName.ReplaceAllString(text.Fold(bike.FullName), "") + "|" + strconv.Itoa(bike.YearModel)
+}
+
+// duplicateScore califica de 0 a 1 que tan probable es que dos publicaciones sean la misma moto.
+// Si ambas tienen municipio y es distinto, o sus fotos no se parecen, no son la misma moto
+func duplicateScore(a, b *domain.Bike, municipalityA, municipalityB string, photosA, photosB []uint64) float64 {
+	if municipalityA != "" && municipalityB != "" && municipalityA != municipalityB {
+		return 0
+	}
+
+	if photoSimilarity(photosA, photosB) < duplicatePhotosMin {
+		return 0
+	}
+
+	score := duplicatePhotosWeight
+
+	kmDiff := math.Abs(float64(a.Kilometers - b.Kilometers))
+	if kmDiff <= math.Max(duplicateMinKmDiff, duplicateKmTolerance*math.Max(float64(a.Kilometers), float64(b.Kilometers))) {
+		score += duplicateKmWeight
+	}
+
+	if maxPrice := math.Max(float64(a.Price), float64(b.Price)); maxPrice > 0 && math.Abs(float64(a.Price-b.Price))/maxPrice <= duplicatePriceTolerance {
+		score += duplicatePriceWeight
+	}
+
+	switch {
+	case municipalityA != "" && municipalityA == municipalityB:
+		score += duplicateLocationWeight
+	case municipalityA == "" || municipalityB == "":
+		// Unknown location neither confirms nor discards
+		score += duplicateLocationWeight / 2
+	}
+
+	return score
+}
+
+// photoSimilarity compara las fotos por su dHash (Jaccard): dos fotos son la misma si sus hashes estan
+// a menos de duplicatePhotoDistance bits, aunque se hayan recomprimido o subido con otra llave en R2.
+// Una foto de portada repetida entre varias publicaciones no alcanza si las demas fotos son distintas
+func photoSimilarity(a, b []uint64) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	used := make([]bool, len(b))
+	intersection := 0
+	for _, hashA := range a {
+		for j, hashB := range b {
+			if !used[j] && photohash.Distance(hashA, hashB) <= duplicatePhotoDistance {
+				used[j] = true
+				intersection++
+				break
+			}
+		}
+	}
+
+	return float64(intersection) / float64(len(a)+len(b)-intersection)
+}
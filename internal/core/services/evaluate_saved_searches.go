@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+const (
+	// savedSearchEvaluatedField marca las motos activas y revisadas que ya se compararon con las busquedas guardadas
+	savedSearchEvaluatedField = "saved_search_evaluated"
+	savedSearchBatchSize      = 500
+	// SavedSearchEvaluateTime es cada cuanto se comparan las motos nuevas con las busquedas guardadas
+	SavedSearchEvaluateTime = 5 * time.Minute
+)
+
+type evaluateSavedSearches struct {
+	mongoRepository       ports.MongoRepository
+	savedSearchRepository ports.SavedSearchRepository
+}
+
+// NewEvaluateSavedSearches crea el evaluador que registra las coincidencias de las motos que se van activando
+func NewEvaluateSavedSearches(mongoRepository ports.MongoRepository, savedSearchRepository ports.SavedSearchRepository) *evaluateSavedSearches {
+	return &evaluateSavedSearches{
+		mongoRepository:       mongoRepository,
+		savedSearchRepository: savedSearchRepository,
+	}
+}
+
+// Execute compara las motos activas y revisadas que aun no se han evaluado contra todas las
+// busquedas guardadas, registra las coincidencias y las marca como evaluadas.
+// Antes libera la marca de las motos que dejaron de estar activas o revisadas, asi una moto que
+// el flujo de ingesta desactiva y vuelve a activar en Mongo se evalua de nuevo
+func (s *evaluateSavedSearches) Execute(ctx context.Context) (*domain.SavedSearchEvaluation, *errorBikes.WrapperError) {
+	evaluation := &domain.SavedSearchEvaluation{}
+
+	inactiveFilter := bson.M{
+		savedSearchEvaluatedField: true,
+		"$or":                     bson.A{bson.M{"active": bson.M{"$ne": true}}, bson.M{"reviewed": bson.M{"$ne": true}}},
+	}
+	if err := s.mongoRepository.UpdateMany(ctx, inactiveFilter, bson.M{savedSearchEvaluatedField: false}); err != nil {
+		return evaluation, err
+	}
+
+	for {
+		pendingFilter := bson.M{"active": true, "reviewed": true, savedSearchEvaluatedField: bson.M{"$ne": true}}
+		findOpts := options.Find().
+			SetProjection(bson.D{{Key: "hash_byke", Value: 1}, {Key: "full_name", Value: 1}, {Key: "price", Value: 1}}).
+			SetLimit(savedSearchBatchSize)
+
+		pending, err := s.mongoRepository.FindBikes(ctx, pendingFilter, findOpts)
+		if err != nil {
+			return evaluation, err
+		}
+
+		if len(pending) == 0 {
+			return evaluation, nil
+		}
+
+		matches, err := s.match(ctx, pending)
+		if err != nil {
+			return evaluation, err
+		}
+
+		if err := s.savedSearchRepository.InsertMatches(ctx, matches); err != nil {
+			return evaluation, err
+		}
+
+		hashes := make([]string, 0, len(pending))
+		for _, bike := range pending {
+			hashes = append(hashes, bike.HashByke)
+		}
+
+		if err := s.mongoRepository.UpdateMany(ctx, bson.M{"hash_byke": bson.M{"$in": hashes}}, bson.M{savedSearchEvaluatedField: true}); err != nil {
+			return evaluation, err
+		}
+
+		evaluation.Evaluated += int64(len(pending))
+		evaluation.Matches += int64(len(matches))
+
+		if len(pending) < savedSearchBatchSize {
+			return evaluation, nil
+		}
+	}
+}
+
+// match busca, para cada busqueda guardada, cuales de las motos pendientes cumplen sus filtros
+// usando el mismo filtro de /search
+func (s *evaluateSavedSearches) match(ctx context.Context, pending []*domain.Bike) ([]*domain.SavedSearchMatch, *errorBikes.WrapperError) {
+	searches, err := s.savedSearchRepository.FindAll(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	bikesByHash := make(map[string]*domain.Bike, len(pending))
+	hashes := make([]string, 0, len(pending))
+	for _, bike := range pending {
+		bikesByHash[bike.HashByke] = bike
+		hashes = append(hashes, bike.HashByke)
+	}
+
+	matchedAt := time.Now().Unix()
+	matches := []*domain.SavedSearchMatch{}
+	for _, search := range searches {
+		request := search.Filters.ToRequest()
+
+		filter := bson.M{"$and": bson.A{buildSearchQuery(request), bson.M{"hash_byke": bson.M{"$in": hashes}}}}
+		findOpts := options.Find().SetProjection(bson.D{{Key: "hash_byke", Value: 1}})
+
+		found, err := s.mongoRepository.FindBikes(ctx, filter, findOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, bike := range found {
+			matched := bikesByHash[bike.HashByke]
+			// The ids are generated in order so clients can poll with after without skipping any
+			matches = append(matches, &domain.SavedSearchMatch{
+				ID:        bson.NewObjectID(),
+				SearchID:  search.ID,
+				HashByke:  matched.HashByke,
+				FullName:  matched.FullName,
+				Price:     matched.Price,
+				MatchedAt: matchedAt,
+			})
+		}
+	}
+
+	return matches, nil
+}
+
+// Run evalua las busquedas guardadas y deja el resultado en el log
+func (s *evaluateSavedSearches) Run(ctx context.Context) {
+	evaluation, err := s.Execute(ctx)
+	if err != nil {
+		log.Printf("error evaluating saved searches: %v", err.Message)
+		return
+	}
+
+	if evaluation.Evaluated > 0 {
+		log.Printf("saved searches evaluated %d bikes, %d matches", evaluation.Evaluated, evaluation.Matches)
+	}
+}
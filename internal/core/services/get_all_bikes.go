@@ -2,12 +2,19 @@ package services
 
 import (
 	"context"
+	"math"
+	"regexp"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
 	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	"github.com/Bikes2Road/bikes-compass/utils/cursor"
 	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+	"github.com/Bikes2Road/bikes-compass/utils/paperwork"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
@@ -16,19 +23,22 @@ type getAllBikes struct {
 	mongoRepository ports.MongoRepository
 	r2Repository    ports.R2Repository
 	cacheRepository ports.CacheRepository[string, any]
+	cursorSecret    []byte
 }
 
-func NewGetAllBikes(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) *getAllBikes {
+func NewGetAllBikes(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any], cursorSecret []byte) *getAllBikes {
 	return &getAllBikes{
 		mongoRepository: mongoRepository,
 		r2Repository:    r2Repository,
 		cacheRepository: cacheRepository,
+		cursorSecret:    cursorSecret,
 	}
 }
 
 func (s *getAllBikes) Execute(ctx context.Context, requestByke domain.GetAllBikesRequest, pathRequest string) (*domain.GetAllResponseSuccess, *domain.ResponseHttpError) {
 
-	if cached, ok := s.cacheRepository.GetCached(pathRequest); ok {
+	cacheKey := searchCachePrefix + pathRequest
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
 		if resp, ok := cached.(*domain.GetAllResponseSuccess); ok {
 			return resp, nil
 		}
@@ -40,17 +50,26 @@ func (s *getAllBikes) Execute(ctx context.Context, requestByke domain.GetAllBike
 	var limit int64
 	expireTime := 15 * 60 * time.Second
 
-	query = bson.M{"active": true, "reviewed": true}
-	if requestByke.Name != "" {
-		query["full_name"] = bson.M{"$regex": requestByke.Name, "$options": "i"}
+	query = buildSearchQuery(requestByke)
+
+	totalBikes, err := s.mongoRepository.Count(ctx, query)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
 	}
 
-	if requestByke.Brand != "" {
-		query["brand"] = bson.M{"$regex": requestByke.Brand, "$options": "i"}
+	// With a cursor the page starts right after the last byke delivered (keyset),
+	// otherwise keep the classic skip by page
+	findQuery := query
+	lastByke, skip, errCursor := cursor.Start(s.cursorSecret, requestByke.Cursor, requestByke.Sort, requestByke.Page, requestByke.Cant)
+	if errCursor != nil {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorInvalidCursor, errCursor)
+	}
+	if lastByke != nil {
+		findQuery = bson.M{"$and": bson.A{query, keysetFilter(lastByke)}}
 	}
 
-	skip = (requestByke.Page - 1) * requestByke.Cant
-	limit = requestByke.Cant
+	// Fetch one extra byke to know if there is a next page
+	limit = requestByke.Cant + 1
 
 	// Extract specific fields
 	fields = bson.D{
@@ -61,23 +80,61 @@ func (s *getAllBikes) Execute(ctx context.Context, requestByke domain.GetAllBike
 		{Key: "year_model", Value: 1},
 		{Key: "km", Value: 1},
 		{Key: "price", Value: 1},
+		{Key: "previous_price", Value: 1},
+		{Key: "price_dropped", Value: 1},
+		{Key: "deal_score", Value: 1},
+		{Key: "deal_percentage", Value: 1},
 		{Key: "location", Value: 1},
 		{Key: "date_publish", Value: 1},
 		{Key: "photos", Value: 1},
 	}
 
+	if requestByke.IsTextSearch() {
+		fields = append(fields, bson.E{Key: "score", Value: bson.M{"$meta": "textScore"}})
+	}
+
+	if requestByke.IsGeoSearch() {
+		fields = append(fields, bson.E{Key: "geo", Value: 1})
+	}
+
 	// Para pasar los fields al método FindAll de Mongo, debes crear una opción de proyección y pasarla como opt.
-	findOpts := options.Find().SetProjection(fields).SetSkip(skip).SetLimit(limit)
+	findOpts := options.Find().SetProjection(fields).SetSort(buildSort(requestByke.Sort)).SetSkip(skip).SetLimit(limit)
 
-	bikes, err := s.mongoRepository.FindAll(ctx, query, findOpts)
+	bikes, err := s.mongoRepository.FindAll(ctx, findQuery, findOpts)
 
 	if err != nil {
 		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
 	}
 
+	hasNext := int64(len(bikes)) > requestByke.Cant
+	if hasNext {
+		bikes = bikes[:requestByke.Cant]
+	}
+
+	if requestByke.IsTextSearch() {
+		for _, byke := range bikes {
+			byke.Highlight = highlightTerms(byke.FullName, requestByke.Name)
+		}
+	}
+
+	if requestByke.IsGeoSearch() {
+		center := location.Coordinates{Lat: *requestByke.Lat, Lng: *requestByke.Lng}
+		for _, byke := range bikes {
+			if byke.Geo == nil || len(byke.Geo.Coordinates) != 2 {
+				continue
+			}
+			distance := math.Round(location.DistanceKm(center, location.Coordinates{Lat: byke.Geo.Coordinates[1], Lng: byke.Geo.Coordinates[0]})*10) / 10
+			byke.Distance = &distance
+		}
+	}
+
 	// Add urls of photos of bikes
 	var wg sync.WaitGroup
 	for i := range bikes {
+		// Listings loaded without photos have no cover to sign
+		if len(bikes[i].Photos) == 0 {
+			continue
+		}
 		for j := range bikes[i].Photos[0] {
 			wg.Add(1)
 			go func(i, j int) {
@@ -94,11 +151,258 @@ func (s *getAllBikes) Execute(ctx context.Context, requestByke domain.GetAllBike
 	}
 	wg.Wait()
 
-	totalBikes := len(bikes)
+	totalPages := (totalBikes + requestByke.Cant - 1) / requestByke.Cant
 
-	response := &domain.GetAllResponseSuccess{Success: true, Data: bikes, Total: int64(totalBikes)}
+	response := &domain.GetAllResponseSuccess{
+		Success:    true,
+		Data:       bikes,
+		Total:      totalBikes,
+		Page:       requestByke.Page,
+		PageSize:   requestByke.Cant,
+		TotalPages: totalPages,
+		HasNext:    hasNext,
+	}
 
-	s.cacheRepository.SetCached(pathRequest, response)
+	// Relevance has no stable field to continue from, so it only pages by number
+	if hasNext && requestByke.Sort != domain.SortRelevance {
+		lastByke := bikes[len(bikes)-1]
+		nextCursor, errCursor := cursor.Encode(s.cursorSecret, cursor.Cursor{
+			Sort:     requestByke.Sort,
+			Value:    sortValue(lastByke, requestByke.Sort),
+			HashByke: lastByke.HashByke,
+		})
+		if errCursor != nil {
+			return nil, errorBikes.MapErrorResponse(errorBikes.ErrorUnexpected, errCursor)
+		}
+		response.NextCursor = nextCursor
+	}
+
+	s.cacheRepository.SetCached(cacheKey, response)
 
 	return response, nil
 }
+
+// listedQuery retorna el filtro base de las motos que se muestran: activas, revisadas y que no son
+// duplicados de otra publicacion (se muestran a traves de su publicacion canonica)
+func listedQuery() bson.M {
+	return bson.M{"active": true, "reviewed": true, "duplicate_of": nil}
+}
+
+// buildSearchQuery arma el filtro de Mongo para la busqueda de motos activas y revisadas.
+// El nombre y la marca se comparan sin importar tildes con el patron de text.AccentInsensitivePattern
+func buildSearchQuery(requestByke domain.GetAllBikesRequest) bson.M {
+	query := listedQuery()
+	if requestByke.IsTextSearch() {
+		query["$text"] = bson.M{"$search": requestByke.Name}
+	} else if requestByke.Name != "" {
+		query["full_name"] = bson.M{"$regex": text.AccentInsensitivePattern(requestByke.Name), "$options": "i"}
+	}
+
+	if requestByke.Brand != "" {
+		query["brand"] = bson.M{"$regex": text.AccentInsensitivePattern(requestByke.Brand), "$options": "i"}
+	}
+
+	if price := rangeFilter(requestByke.PriceMin, requestByke.PriceMax); price != nil {
+		query["price"] = price
+	}
+
+	if year := rangeFilter(requestByke.YearMin, requestByke.YearMax); year != nil {
+		query["year_model"] = year
+	}
+
+	if requestByke.KmMax > 0 {
+		query["km"] = bson.M{"$lte": requestByke.KmMax}
+	}
+
+	// Specs are filtered with the numeric values parsed by the specs backfill
+	if cylinder := rangeFilter(requestByke.CcMin, requestByke.CcMax); cylinder != nil {
+		query["cylinder_cc"] = cylinder
+	}
+
+	if requestByke.HpMin > 0 {
+		query["horse_power_hp"] = bson.M{"$gte": requestByke.HpMin}
+	}
+
+	// A document is valid until the end of its expiry day
+	if requestByke.DocsValid {
+		today := paperwork.StartOfDay(time.Now()).Unix()
+		query["soat_expires_at"] = bson.M{"$gte": today}
+		query["tecnico_expires_at"] = bson.M{"$gte": today}
+	}
+
+	if tags := requestByke.ExtraTags(); len(tags) > 0 {
+		query["extras_tags"] = bson.M{"$all": tags}
+	}
+
+	if deals := requestByke.Deals(); len(deals) > 0 {
+		query["deal_score"] = bson.M{"$in": deals}
+	} else if requestByke.Sort == domain.SortDeal {
+		// Bikes without enough comparables have no deal and would be sorted first
+		query["deal_score"] = bson.M{"$ne": nil}
+	}
+
+	// Location is free text, so city and department are matched with the names of the catalog
+	conditions := bson.A{}
+	department := location.FindDepartment(requestByke.Department)
+	if municipalities := cityMunicipalities(requestByke.City, department); len(municipalities) > 0 {
+		names := []string{}
+		for _, municipality := range municipalities {
+			names = append(names, municipality.Names()...)
+		}
+		conditions = append(conditions, bson.M{"location": bson.M{"$regex": location.Pattern(names), "$options": "i"}})
+	}
+
+	if requestByke.Department != "" && department != nil {
+		conditions = append(conditions, bson.M{"location": bson.M{"$regex": location.Pattern(location.DepartmentNames(department.Code)), "$options": "i"}})
+	}
+
+	if requestByke.IsGeoSearch() {
+		center := bson.A{*requestByke.Lng, *requestByke.Lat}
+		query["geo"] = bson.M{"$geoWithin": bson.M{"$centerSphere": bson.A{center, location.RadiansFromKm(*requestByke.RadiusKm)}}}
+	}
+
+	if len(conditions) > 0 {
+		query["$and"] = conditions
+	}
+
+	return query
+}
+
+// rangeFilter arma un filtro $gte/$lte con los limites informados, nil si no hay ninguno
+func rangeFilter(min, max int64) bson.M {
+	if min <= 0 && max <= 0 {
+		return nil
+	}
+
+	filter := bson.M{}
+	if min > 0 {
+		filter["$gte"] = min
+	}
+
+	if max > 0 {
+		filter["$lte"] = max
+	}
+
+	return filter
+}
+
+type sortField struct {
+	key   string
+	order int
+}
+
+// sortFields relaciona cada ordenamiento soportado con el campo de Mongo y su direccion
+var sortFields = map[string]sortField{
+	domain.SortPriceAsc:  {key: "price", order: 1},
+	domain.SortPriceDesc: {key: "price", order: -1},
+	domain.SortNewest:    {key: "date_publish", order: -1},
+	domain.SortKmAsc:     {key: "km", order: 1},
+	domain.SortYearDesc:  {key: "year_model", order: -1},
+	domain.SortDeal:      {key: "deal_percentage", order: 1},
+}
+
+func getSortField(sort string) sortField {
+	field, ok := sortFields[sort]
+	if !ok {
+		return sortFields[domain.SortNewest]
+	}
+	return field
+}
+
+// buildSort arma el ordenamiento de la busqueda, usando hash_byke como desempate
+// para que la paginacion sea estable
+func buildSort(sort string) bson.D {
+	if sort == domain.SortRelevance {
+		return bson.D{
+			{Key: "score", Value: bson.M{"$meta": "textScore"}},
+			{Key: "hash_byke", Value: 1},
+		}
+	}
+
+	field := getSortField(sort)
+
+	return bson.D{
+		{Key: field.key, Value: field.order},
+		{Key: "hash_byke", Value: 1},
+	}
+}
+
+// keysetFilter arma el filtro para continuar despues de la ultima moto del cursor
+// respetando el mismo orden de buildSort
+func keysetFilter(lastByke *cursor.Cursor) bson.M {
+	field := getSortField(lastByke.Sort)
+
+	operator := "$gt"
+	if field.order < 0 {
+		operator = "$lt"
+	}
+
+	return bson.M{"$or": bson.A{
+		bson.M{field.key: bson.M{operator: lastByke.Value}},
+		bson.M{field.key: lastByke.Value, "hash_byke": bson.M{"$gt": lastByke.HashByke}},
+	}}
+}
+
+// sortValue extrae de la moto el valor del campo por el que se ordena
+func sortValue(byke *domain.BykeReponse, sort string) float64 {
+	switch getSortField(sort).key {
+	case "price":
+		return float64(byke.Price)
+	case "km":
+		return float64(byke.Kilometers)
+	case "year_model":
+		return float64(byke.YearModel)
+	case "deal_percentage":
+		if byke.DealPercentage == nil {
+			return 0
+		}
+		return *byke.DealPercentage
+	default:
+		return float64(byke.DatePublish)
+	}
+}
+
+// highlightTerms envuelve en <em> los terminos de la busqueda que aparecen en el nombre, sin importar tildes
+func highlightTerms(name, search string) string {
+	terms := strings.Fields(search)
+	if len(terms) == 0 {
+		return name
+	}
+
+	for i, term := range terms {
+		terms[i] = text.AccentInsensitivePattern(term)
+	}
+
+	pattern, err := regexp.Compile(`(?i)(` + strings.Join(terms, "|") + `)`)
+	if err != nil {
+		return name
+	}
+
+	return pattern.ReplaceAllString(name, "<em>$1</em>")
+}
+
+// cityMunicipalities retorna los municipios del filtro city. Si el nombre existe en varios departamentos
+// se dejan los del departamento del filtro department cuando se envia
+func cityMunicipalities(city string, department *location.Department) []location.Municipality {
+	if city == "" {
+		return nil
+	}
+
+	municipalities := location.FindMunicipalities(city)
+	if department == nil {
+		return municipalities
+	}
+
+	inDepartment := []location.Municipality{}
+	for _, municipality := range municipalities {
+		if municipality.DepartmentCode == department.Code {
+			inDepartment = append(inDepartment, municipality)
+		}
+	}
+
+	if len(inDepartment) == 0 {
+		return municipalities
+	}
+
+	return inDepartment
+}
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// batchPresignWorkers limita las llamadas simultaneas a R2 al firmar las fotos de un lote
+const batchPresignWorkers = 8
+
+type getBikesBatch struct {
+	mongoRepository ports.MongoRepository
+	r2Repository    ports.R2Repository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetBikesBatch(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) *getBikesBatch {
+	return &getBikesBatch{
+		mongoRepository: mongoRepository,
+		r2Repository:    r2Repository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+func (s *getBikesBatch) Execute(ctx context.Context, requestByke domain.BatchBikesRequest) (*domain.BatchBikesResponseSuccess, *domain.ResponseHttpError) {
+	expireTime := 15 * 60 * time.Second
+	now := time.Now()
+
+	// The detail of each byke is shared with getByke, so only the ones not cached are searched
+	byHash := map[string]*domain.FullBykeResponse{}
+	pending := []string{}
+	for _, hash := range requestByke.Hashes {
+		if cached, ok := s.cacheRepository.GetCached(bykeCacheKey(hash)); ok {
+			if resp, ok := cached.(*domain.GetBykeResponseSuccess); ok {
+				byHash[hash] = withDocumentStatus(resp.Data, now)
+				continue
+			}
+		}
+		pending = append(pending, hash)
+	}
+
+	if len(pending) > 0 {
+		found, err := s.mongoRepository.FindByHashes(ctx, bson.M{"hash_byke": bson.M{"$in": pending}}, options.Find().SetProjection(fullBykeProjection))
+		if err != nil {
+			return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+		}
+
+		photos := make([][][]domain.Photo, 0, len(found))
+		for _, byke := range found {
+			photos = append(photos, byke.Photos)
+		}
+		presignPhotosLimit(ctx, s.r2Repository, photos, expireTime, batchPresignWorkers)
+
+		for _, byke := range found {
+			s.cacheRepository.SetCached(bykeCacheKey(byke.HashByke), &domain.GetBykeResponseSuccess{Success: true, Data: byke, Total: 1})
+			byHash[byke.HashByke] = withDocumentStatus(byke, now)
+		}
+	}
+
+	bikes := []*domain.FullBykeResponse{}
+	missing := []string{}
+	for _, hash := range requestByke.Hashes {
+		byke, ok := byHash[hash]
+		if !ok {
+			missing = append(missing, hash)
+			continue
+		}
+		bikes = append(bikes, byke)
+	}
+
+	return &domain.BatchBikesResponseSuccess{Success: true, Data: bikes, Missing: missing, Total: int64(len(bikes))}, nil
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// catalogCacheTTL es el tiempo de vida en cache de las marcas y modelos, cambian mucho menos que las busquedas
+const catalogCacheTTL = 6 * time.Hour
+
+type getBrands struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetBrands(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getBrands {
+	return &getBrands{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute lista las marcas con motos publicadas, de la que mas tiene a la que menos
+func (s *getBrands) Execute(ctx context.Context) (*domain.BrandsResponseSuccess, *domain.ResponseHttpError) {
+	if cached, ok := s.cacheRepository.GetCached(brandsCacheKey); ok {
+		if resp, ok := cached.(*domain.BrandsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	brands, err := s.mongoRepository.FindCatalog(ctx, listedQuery(), "brand")
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	response := &domain.BrandsResponseSuccess{Success: true, Data: brands, Total: int64(len(brands))}
+
+	s.cacheRepository.SetCachedWithTTL(brandsCacheKey, response, catalogCacheTTL)
+
+	return response, nil
+}
+
+type getBrandModels struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetBrandModels(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getBrandModels {
+	return &getBrandModels{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute lista los modelos con motos publicadas de una marca, sin importar mayusculas ni tildes en la marca
+func (s *getBrandModels) Execute(ctx context.Context, request domain.BrandModelsRequest) (*domain.ModelsResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := brandModelsCacheKey(request.Brand)
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.ModelsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	query := listedQuery()
+	query["brand"] = bson.M{"$regex": `^\s*` + text.AccentInsensitivePattern(request.Brand) + `\s*$`, "$options": "i"}
+
+	models, err := s.mongoRepository.FindCatalog(ctx, query, "model")
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	if len(models) == 0 {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorBrandNotFound, nil)
+	}
+
+	response := &domain.ModelsResponseSuccess{Success: true, Brand: strings.Join(strings.Fields(request.Brand), " "), Data: models, Total: int64(len(models))}
+
+	s.cacheRepository.SetCachedWithTTL(cacheKey, response, catalogCacheTTL)
+
+	return response, nil
+}
@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
@@ -12,6 +11,43 @@ import (
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// fullBykeProjection son los campos del detalle de una moto
+var fullBykeProjection = bson.D{
+	{Key: "ref", Value: 1},
+	{Key: "hash_byke", Value: 1},
+	{Key: "full_name", Value: 1},
+	{Key: "brand", Value: 1},
+	{Key: "model", Value: 1},
+	{Key: "cylinder", Value: 1},
+	{Key: "engine", Value: 1},
+	{Key: "horse_power", Value: 1},
+	{Key: "weight", Value: 1},
+	{Key: "city_register", Value: 1},
+	{Key: "extras", Value: 1},
+	{Key: "extras_tags", Value: 1},
+	{Key: "date_found", Value: 1},
+	{Key: "date_soat", Value: 1},
+	{Key: "date_tecnico", Value: 1},
+	{Key: "page_instagram", Value: 1},
+	{Key: "url_post", Value: 1},
+	{Key: "year_model", Value: 1},
+	{Key: "km", Value: 1},
+	{Key: "price", Value: 1},
+	{Key: "location", Value: 1},
+	{Key: "date_publish", Value: 1},
+	{Key: "photos", Value: 1},
+	{Key: "torque", Value: 1},
+	{Key: "previous_price", Value: 1},
+	{Key: "price_dropped", Value: 1},
+	{Key: "deal_score", Value: 1},
+	{Key: "deal_percentage", Value: 1},
+	{Key: "cylinder_cc", Value: 1},
+	{Key: "horse_power_hp", Value: 1},
+	{Key: "torque_nm", Value: 1},
+	{Key: "weight_kg", Value: 1},
+	{Key: "version", Value: 1},
+}
+
 type getByke struct {
 	mongoRepository ports.MongoRepository
 	r2Repository    ports.R2Repository
@@ -26,10 +62,11 @@ func NewGetByke(mongoRepository ports.MongoRepository, r2Repository ports.R2Repo
 	}
 }
 
-func (s *getByke) Execute(ctx context.Context, requestByke domain.SearchBykeRequest, pathRequest string) (*domain.GetBykeResponseSuccess, *domain.ResponseHttpError) {
-	if cached, ok := s.cacheRepository.GetCached(pathRequest); ok {
+func (s *getByke) Execute(ctx context.Context, requestByke domain.SearchBykeRequest) (*domain.GetBykeResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := bykeCacheKey(requestByke.HashByke)
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
 		if resp, ok := cached.(*domain.GetBykeResponseSuccess); ok {
-			return resp, nil
+			return bykeResponseAt(resp, time.Now()), nil
 		}
 	}
 
@@ -44,31 +81,7 @@ func (s *getByke) Execute(ctx context.Context, requestByke domain.SearchBykeRequ
 
 	// Extract specific fields
 	// Crear opciones para obtener solo los campos seleccionados usando Projection
-	findOpts := options.FindOne().SetProjection(bson.D{
-		{Key: "ref", Value: 1},
-		{Key: "hash_byke", Value: 1},
-		{Key: "full_name", Value: 1},
-		{Key: "brand", Value: 1},
-		{Key: "model", Value: 1},
-		{Key: "cylinder", Value: 1},
-		{Key: "engine", Value: 1},
-		{Key: "horse_power", Value: 1},
-		{Key: "weight", Value: 1},
-		{Key: "city_register", Value: 1},
-		{Key: "extras", Value: 1},
-		{Key: "date_found", Value: 1},
-		{Key: "date_soat", Value: 1},
-		{Key: "date_tecnico", Value: 1},
-		{Key: "page_instagram", Value: 1},
-		{Key: "url_post", Value: 1},
-		{Key: "year_model", Value: 1},
-		{Key: "km", Value: 1},
-		{Key: "price", Value: 1},
-		{Key: "location", Value: 1},
-		{Key: "date_publish", Value: 1},
-		{Key: "photos", Value: 1},
-		{Key: "torque", Value: 1},
-	})
+	findOpts := options.FindOne().SetProjection(fullBykeProjection)
 
 	byke, err := s.mongoRepository.FindByHash(ctx, query, findOpts)
 	if err != nil {
@@ -76,27 +89,18 @@ func (s *getByke) Execute(ctx context.Context, requestByke domain.SearchBykeRequ
 	}
 
 	// Add urls of photos of bike
-	var wg sync.WaitGroup
-	for i := range byke.Photos {
-		for j := range byke.Photos[i] {
-			wg.Add(1)
-			go func(i, j int) {
-				defer wg.Done()
-				url, err := s.r2Repository.GetPresignedURL(ctx, byke.Photos[i][j].Key, expireTime)
-				if err != nil {
-					// If error, set empty string and continue
-					byke.Photos[i][j].Url = ""
-					return
-				}
-				byke.Photos[i][j].Url = url
-			}(i, j)
-		}
-	}
-	wg.Wait()
+	presignPhotos(ctx, s.r2Repository, byke.Photos, expireTime)
 
 	response := &domain.GetBykeResponseSuccess{Success: true, Data: byke, Total: 1}
 
-	s.cacheRepository.SetCached(pathRequest, response)
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return bykeResponseAt(response, time.Now()), nil
+}
 
-	return response, nil
+// bykeResponseAt copia la respuesta guardada en cache con el estado de los documentos a la fecha indicada
+func bykeResponseAt(response *domain.GetBykeResponseSuccess, now time.Time) *domain.GetBykeResponseSuccess {
+	copied := *response
+	copied.Data = withDocumentStatus(response.Data, now)
+	return &copied
 }
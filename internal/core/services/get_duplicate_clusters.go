@@ -0,0 +1,113 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+var duplicateMemberProjection = bson.D{
+	{Key: "hash_byke", Value: 1},
+	{Key: "full_name", Value: 1},
+	{Key: "year_model", Value: 1},
+	{Key: "km", Value: 1},
+	{Key: "price", Value: 1},
+	{Key: "location", Value: 1},
+	{Key: "page_instagram", Value: 1},
+	{Key: "url_post", Value: 1},
+	{Key: "date_publish", Value: 1},
+	{Key: "duplicate_of", Value: 1},
+}
+
+type getDuplicateClusters struct {
+	mongoRepository ports.MongoRepository
+}
+
+func NewGetDuplicateClusters(mongoRepository ports.MongoRepository) *getDuplicateClusters {
+	return &getDuplicateClusters{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// Execute arma los grupos de duplicados a partir de las publicaciones activas con duplicate_of y sus canonicas
+func (s *getDuplicateClusters) Execute(ctx context.Context) (*domain.DuplicateClustersResponseSuccess, *domain.ResponseHttpError) {
+	findOpts := options.Find().SetProjection(duplicateMemberProjection)
+
+	duplicates, err := s.mongoRepository.FindBikes(ctx, bson.M{"active": true, "duplicate_of": bson.M{"$ne": nil}}, findOpts)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	groups := map[string][]*domain.Bike{}
+	canonicalHashes := []string{}
+	for _, bike := range duplicates {
+		if _, ok := groups[bike.DuplicateOf]; !ok {
+			canonicalHashes = append(canonicalHashes, bike.DuplicateOf)
+		}
+		groups[bike.DuplicateOf] = append(groups[bike.DuplicateOf], bike)
+	}
+
+	canonicals := map[string]*domain.Bike{}
+	if len(canonicalHashes) > 0 {
+		found, err := s.mongoRepository.FindBikes(ctx, bson.M{"hash_byke": bson.M{"$in": canonicalHashes}}, findOpts)
+		if err != nil {
+			return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+		}
+
+		for _, bike := range found {
+			canonicals[bike.HashByke] = bike
+		}
+	}
+
+	clusters := make([]domain.DuplicateCluster, 0, len(canonicalHashes))
+	for _, groupID := range canonicalHashes {
+		members := groups[groupID]
+		sort.Slice(members, func(i, j int) bool {
+			return members[i].DatePublish < members[j].DatePublish
+		})
+
+		cluster := domain.DuplicateCluster{GroupID: groupID}
+		if canonical, ok := canonicals[groupID]; ok {
+			cluster.Members = append(cluster.Members, toDuplicateMember(canonical, true))
+		}
+		for _, member := range members {
+			cluster.Members = append(cluster.Members, toDuplicateMember(member, false))
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	// Biggest groups first, they are the pages that republish the most
+	sort.SliceStable(clusters, func(i, j int) bool {
+		if len(clusters[i].Members) != len(clusters[j].Members) {
+			return len(clusters[i].Members) > len(clusters[j].Members)
+		}
+		return clusters[i].GroupID < clusters[j].GroupID
+	})
+
+	return &domain.DuplicateClustersResponseSuccess{
+		Success: true,
+		Data:    clusters,
+		Total:   int64(len(clusters)),
+	}, nil
+}
+
+func toDuplicateMember(bike *domain.Bike, canonical bool) domain.DuplicateMember {
+	return domain.DuplicateMember{
+		HashByke:      bike.HashByke,
+		FullName:      bike.FullName,
+		YearModel:     bike.YearModel,
+		Kilometers:    bike.Kilometers,
+		Price:         bike.Price,
+		Location:      bike.Location,
+		PageInstagram: bike.PageInstagram,
+		UrlPost:       bike.UrlPost,
+		DatePublish:   bike.DatePublish,
+		Canonical:     canonical,
+	}
+}
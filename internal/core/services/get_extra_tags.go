@@ -0,0 +1,54 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/extras"
+)
+
+type getExtraTags struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetExtraTags(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getExtraTags {
+	return &getExtraTags{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute lista las etiquetas del diccionario en su orden, con la cantidad de motos publicadas que las tienen
+func (s *getExtraTags) Execute(ctx context.Context) (*domain.ExtraTagsResponseSuccess, *domain.ResponseHttpError) {
+	if cached, ok := s.cacheRepository.GetCached(extraTagsCacheKey); ok {
+		if resp, ok := cached.(*domain.ExtraTagsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	query := listedQuery()
+
+	counts, err := s.mongoRepository.CountBy(ctx, query, "extras_tags")
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	byTag := map[string]int64{}
+	for _, count := range counts {
+		byTag[count.Value] = count.Count
+	}
+
+	tags := make([]domain.ExtraTagCount, 0, len(extras.All()))
+	for _, tag := range extras.All() {
+		tags = append(tags, domain.ExtraTagCount{Tag: tag.Tag, Label: tag.Label, Count: byTag[tag.Tag]})
+	}
+
+	response := &domain.ExtraTagsResponseSuccess{Success: true, Data: tags, Total: int64(len(tags))}
+
+	s.cacheRepository.SetCached(extraTagsCacheKey, response)
+
+	return response, nil
+}
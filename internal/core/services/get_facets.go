@@ -0,0 +1,105 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo"
+)
+
+// Limites de los rangos de precio (COP) y kilometraje, el ultimo valor agrupa todo lo que este por encima
+var (
+	priceBoundaries = []int64{0, 5000000, 10000000, 15000000, 20000000, 30000000, 50000000, 80000000}
+	kmBoundaries    = []int64{0, 5000, 10000, 20000, 40000, 60000, 100000}
+)
+
+type getFacets struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetFacets(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getFacets {
+	return &getFacets{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+func (s *getFacets) Execute(ctx context.Context, requestByke domain.GetAllBikesRequest, pathRequest string) (*domain.FacetsResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := facetsCachePrefix + pathRequest
+
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.FacetsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	query := buildSearchQuery(requestByke)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: query}},
+		{{Key: "$facet", Value: bson.D{
+			{Key: "brands", Value: countBy("brand")},
+			{Key: "locations", Value: countBy("location")},
+			{Key: "years", Value: bson.A{
+				bson.D{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$year_model"}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+				bson.D{{Key: "$sort", Value: bson.D{{Key: "_id", Value: -1}}}},
+			}},
+			{Key: "prices", Value: bucketBy("price", bson.D{{Key: "$gt", Value: 0}}, priceBoundaries)},
+			{Key: "km", Value: bucketBy("km", bson.D{{Key: "$gte", Value: 0}}, kmBoundaries)},
+		}}},
+	}
+
+	facets, err := s.mongoRepository.FindFacets(ctx, pipeline)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	facets.Locations = countByMunicipality(facets.RawLocations)
+	setRangeMax(facets.Prices, priceBoundaries)
+	setRangeMax(facets.Kilometers, kmBoundaries)
+
+	response := &domain.FacetsResponseSuccess{Success: true, Data: facets}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return response, nil
+}
+
+// countBy agrupa por un campo de texto ordenando por cantidad de motos
+func countBy(field string) bson.A {
+	return bson.A{
+		bson.D{{Key: "$match", Value: bson.D{{Key: field, Value: bson.D{{Key: "$nin", Value: bson.A{"", nil}}}}}}},
+		bson.D{{Key: "$group", Value: bson.D{{Key: "_id", Value: "$" + field}, {Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}}},
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+}
+
+// bucketBy agrupa un campo numerico en los rangos definidos por boundaries. Solo se cuentan los valores
+// numericos que cumplen valid, asi los negativos o mal cargados no caen en el ultimo rango
+func bucketBy(field string, valid bson.D, boundaries []int64) bson.A {
+	return bson.A{
+		bson.D{{Key: "$match", Value: bson.D{{Key: field, Value: valid}}}},
+		bson.D{{Key: "$bucket", Value: bson.D{
+			{Key: "groupBy", Value: "$" + field},
+			{Key: "boundaries", Value: boundaries},
+			{Key: "default", Value: boundaries[len(boundaries)-1]},
+			{Key: "output", Value: bson.D{{Key: "count", Value: bson.D{{Key: "$sum", Value: 1}}}}},
+		}}},
+	}
+}
+
+// setRangeMax completa el limite superior de cada rango a partir de su limite inferior
+func setRangeMax(ranges []domain.RangeFacetCount, boundaries []int64) {
+	for i := range ranges {
+		for j := 0; j < len(boundaries)-1; j++ {
+			if boundaries[j] == ranges[i].Min {
+				ranges[i].Max = boundaries[j+1]
+				break
+			}
+		}
+	}
+}
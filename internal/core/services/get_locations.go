@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"sort"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/location"
+)
+
+type getLocations struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetLocations(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getLocations {
+	return &getLocations{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+func (s *getLocations) Execute(ctx context.Context) (*domain.LocationsResponseSuccess, *domain.ResponseHttpError) {
+	if cached, ok := s.cacheRepository.GetCached(locationsCacheKey); ok {
+		if resp, ok := cached.(*domain.LocationsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	query := listedQuery()
+
+	counts, err := s.mongoRepository.CountBy(ctx, query, "location")
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	locations := countByMunicipality(counts)
+
+	response := &domain.LocationsResponseSuccess{Success: true, Data: locations, Total: int64(len(locations))}
+
+	s.cacheRepository.SetCached(locationsCacheKey, response)
+
+	return response, nil
+}
+
+// countByMunicipality suma los conteos de las distintas formas de escribir una ubicacion en su municipio del catalogo,
+// las ubicaciones que no se pueden normalizar se dejan por fuera
+func countByMunicipality(counts []domain.FacetCount) []domain.LocationCount {
+	byCode := map[string]*domain.LocationCount{}
+	for _, count := range counts {
+		match := location.Normalize(count.Value)
+		if match.Municipality == nil {
+			continue
+		}
+
+		if current, ok := byCode[match.Municipality.Code]; ok {
+			current.Count += count.Count
+			continue
+		}
+
+		byCode[match.Municipality.Code] = &domain.LocationCount{
+			Code:           match.Municipality.Code,
+			City:           match.Municipality.Name,
+			DepartmentCode: match.Department.Code,
+			Department:     match.Department.Name,
+			Count:          count.Count,
+		}
+	}
+
+	locations := make([]domain.LocationCount, 0, len(byCode))
+	for _, locationCount := range byCode {
+		locations = append(locations, *locationCount)
+	}
+
+	sort.Slice(locations, func(i, j int) bool {
+		if locations[i].Count != locations[j].Count {
+			return locations[i].Count > locations[j].Count
+		}
+		return locations[i].Code < locations[j].Code
+	})
+
+	return locations
+}
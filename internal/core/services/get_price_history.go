@@ -0,0 +1,55 @@
+package services
+
+import (
+	"context"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type getPriceHistory struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetPriceHistory(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getPriceHistory {
+	return &getPriceHistory{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+func (s *getPriceHistory) Execute(ctx context.Context, requestByke domain.SearchBykeRequest) (*domain.PriceHistoryResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := priceHistoryCacheKey(requestByke.HashByke)
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.PriceHistoryResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	findOpts := options.FindOne().SetProjection(bson.D{{Key: "hash_byke", Value: 1}, {Key: "price", Value: 1}})
+
+	byke, err := s.mongoRepository.FindByHash(ctx, bson.M{"hash_byke": requestByke.HashByke}, findOpts)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	changes, err := s.mongoRepository.FindPriceHistory(ctx, requestByke.HashByke)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	response := &domain.PriceHistoryResponseSuccess{
+		Success:      true,
+		Data:         changes,
+		CurrentPrice: byke.Price,
+		Total:        int64(len(changes)),
+	}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return response, nil
+}
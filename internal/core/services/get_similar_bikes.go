@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/specs"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Peso de cada criterio en la similitud, suman 1
+const (
+	similarBrandWeight    = 0.20
+	similarModelWeight    = 0.20
+	similarCylinderWeight = 0.20
+	similarYearWeight     = 0.15
+	similarKmWeight       = 0.10
+	similarPriceWeight    = 0.15
+)
+
+const (
+	// similarCandidates limita cuantas motos se comparan contra la publicacion
+	similarCandidates = 300
+	// similarYearRange es la diferencia de años a partir de la cual el año no suma
+	similarYearRange = 5
+	// similarKmRange es la diferencia de km a partir de la cual el kilometraje no suma
+	similarKmRange = 30000
+)
+
+// cylinderClasses son los limites (cc) de cada clase de cilindraje
+var cylinderClasses = []int{125, 250, 400, 650, 900}
+
+type getSimilarBikes struct {
+	mongoRepository ports.MongoRepository
+	r2Repository    ports.R2Repository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetSimilarBikes(mongoRepository ports.MongoRepository, r2Repository ports.R2Repository, cacheRepository ports.CacheRepository[string, any]) *getSimilarBikes {
+	return &getSimilarBikes{
+		mongoRepository: mongoRepository,
+		r2Repository:    r2Repository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+type similarCandidate struct {
+	bike  *domain.Bike
+	score float64
+}
+
+func (s *getSimilarBikes) Execute(ctx context.Context, requestByke domain.SimilarBikesRequest, pathRequest string) (*domain.SimilarBikesResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := similarCachePrefix + pathRequest
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.SimilarBikesResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	expireTime := 15 * 60 * time.Second
+
+	source, err := s.mongoRepository.FindByHash(ctx, bson.M{"hash_byke": requestByke.HashByke}, options.FindOne().SetProjection(bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "brand", Value: 1},
+		{Key: "model", Value: 1},
+		{Key: "cylinder", Value: 1},
+		{Key: "cylinder_cc", Value: 1},
+		{Key: "year_model", Value: 1},
+		{Key: "km", Value: 1},
+		{Key: "price", Value: 1},
+	}))
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	// Candidates share the brand or have a close price, the ranking is done below
+	candidatesFilter := listedQuery()
+	candidatesFilter["hash_byke"] = bson.M{"$ne": source.HashByke}
+	candidatesFilter["$or"] = bson.A{
+		bson.M{"brand": source.Brand},
+		bson.M{"price": bson.M{"$gte": source.Price / 2, "$lte": source.Price * 3 / 2}},
+	}
+
+	findOpts := options.Find().
+		SetProjection(bson.D{
+			{Key: "ref", Value: 1},
+			{Key: "hash_byke", Value: 1},
+			{Key: "full_name", Value: 1},
+			{Key: "brand", Value: 1},
+			{Key: "model", Value: 1},
+			{Key: "cylinder", Value: 1},
+			{Key: "cylinder_cc", Value: 1},
+			{Key: "year_model", Value: 1},
+			{Key: "km", Value: 1},
+			{Key: "price", Value: 1},
+			{Key: "previous_price", Value: 1},
+			{Key: "price_dropped", Value: 1},
+			{Key: "deal_score", Value: 1},
+			{Key: "deal_percentage", Value: 1},
+			{Key: "location", Value: 1},
+			{Key: "date_publish", Value: 1},
+			{Key: "photos", Value: bson.M{"$slice": 1}},
+		}).
+		SetSort(bson.D{{Key: "date_publish", Value: -1}, {Key: "hash_byke", Value: 1}}).
+		SetLimit(similarCandidates)
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, candidatesFilter, findOpts)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	candidates := make([]similarCandidate, 0, len(bikes))
+	for _, bike := range bikes {
+		candidates = append(candidates, similarCandidate{bike: bike, score: similarity(source, bike)})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	if int64(len(candidates)) > requestByke.Cant {
+		candidates = candidates[:requestByke.Cant]
+	}
+
+	similar := make([]*domain.BykeReponse, 0, len(candidates))
+	for _, candidate := range candidates {
+		similar = append(similar, &domain.BykeReponse{
+			Ref:            candidate.bike.Ref,
+			HashByke:       candidate.bike.HashByke,
+			FullName:       candidate.bike.FullName,
+			YearModel:      candidate.bike.YearModel,
+			Kilometers:     candidate.bike.Kilometers,
+			Price:          candidate.bike.Price,
+			PreviousPrice:  candidate.bike.PreviousPrice,
+			PriceDropped:   candidate.bike.PriceDropped,
+			DealScore:      candidate.bike.DealScore,
+			DealPercentage: candidate.bike.DealPercentage,
+			Location:       candidate.bike.Location,
+			DatePublish:    candidate.bike.DatePublish,
+			Photos:         candidate.bike.Photos,
+			Similarity:     math.Round(candidate.score*100) / 100,
+		})
+	}
+
+	// Add urls of photos of bikes
+	var wg sync.WaitGroup
+	for _, byke := range similar {
+		wg.Add(1)
+		go func(byke *domain.BykeReponse) {
+			defer wg.Done()
+			presignPhotos(ctx, s.r2Repository, byke.Photos, expireTime)
+		}(byke)
+	}
+	wg.Wait()
+
+	response := &domain.SimilarBikesResponseSuccess{Success: true, Data: similar, Total: int64(len(similar))}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return response, nil
+}
+
+// similarity califica de 0 a 1 que tan parecida es una moto a la publicacion consultada
+func similarity(source *domain.FullBykeResponse, bike *domain.Bike) float64 {
+	score := 0.0
+
+	sameBrand := text.Fold(source.Brand) != "" && text.Fold(source.Brand) == text.Fold(bike.Brand)
+	if sameBrand {
+		score += similarBrandWeight
+		if model := text.Fold(source.Model); model != "" && model == text.Fold(bike.Model) {
+			score += similarModelWeight
+		}
+	}
+
+	sourceClass, okSource := cylinderClass(source.CylinderCc, source.Cylinder)
+	bikeClass, okBike := cylinderClass(bike.CylinderCc, bike.Cylinder)
+	if okSource && okBike {
+		score += similarCylinderWeight * proximity(float64(sourceClass-bikeClass), 2)
+	}
+
+	score += similarYearWeight * proximity(float64(source.YearModel-bike.YearModel), similarYearRange)
+	score += similarKmWeight * proximity(float64(source.Kilometers-bike.Kilometers), similarKmRange)
+
+	if source.Price > 0 {
+		score += similarPriceWeight * proximity(float64(source.Price-bike.Price), float64(source.Price)/2)
+	}
+
+	return score
+}
+
+// proximity retorna 1 si la diferencia es 0 y baja linealmente hasta 0 cuando llega a maxDiff
+func proximity(diff, maxDiff float64) float64 {
+	return math.Max(0, 1-math.Abs(diff)/maxDiff)
+}
+
+// cylinderClass ubica el cilindraje en una de las clases de cylinderClasses. Usa el cylinder_cc guardado
+// y, si la moto aun no lo tiene, interpreta el texto (por ejemplo "321 cc" o "1.2 L") igual que el backfill de specs
+func cylinderClass(cylinderCc *float64, cylinder string) (int, bool) {
+	cc, ok := 0.0, false
+	if cylinderCc != nil {
+		cc, ok = *cylinderCc, true
+	} else {
+		cc, ok = specs.Cylinder(cylinder)
+	}
+	if !ok || cc <= 0 {
+		return 0, false
+	}
+	return sort.SearchInts(cylinderClasses, int(math.Round(cc))), true
+}
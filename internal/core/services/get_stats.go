@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/paperwork"
+	"github.com/Bikes2Road/bikes-compass/utils/stats"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+const (
+	// statsDefaultDays es el rango que se usa cuando no se envia from
+	statsDefaultDays = 30
+	// statsMaxDays es el rango maximo que se puede consultar
+	statsMaxDays = 366
+	// statsDateLayout es el formato de las fechas from y to
+	statsDateLayout = time.DateOnly
+)
+
+type getStats struct {
+	statsRepository ports.StatsRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetStats(statsRepository ports.StatsRepository, cacheRepository ports.CacheRepository[string, any]) *getStats {
+	return &getStats{
+		statsRepository: statsRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute calcula las estadisticas de las publicaciones revisadas entre from y to (incluido), por defecto los ultimos 30 dias
+func (s *getStats) Execute(ctx context.Context, request domain.StatsRequest, pathRequest string) (*domain.StatsResponseSuccess, *domain.ResponseHttpError) {
+	from, to, ok := statsRange(request, time.Now())
+	if !ok {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorInvalidStatsRange, nil)
+	}
+
+	cacheKey := fmt.Sprintf("%s%s|%s|%s", statsCachePrefix, pathRequest, from.Format(statsDateLayout), to.Format(statsDateLayout))
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.StatsResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	end := to.AddDate(0, 0, 1)
+	dateRange := bson.M{"$gte": from.Unix(), "$lt": end.Unix()}
+	query := bson.M{
+		"reviewed": true,
+		"$or": bson.A{
+			bson.M{"date_publish": dateRange},
+			bson.M{"date_publish": bson.M{"$in": bson.A{0, nil}}, "date_found": dateRange},
+		},
+	}
+
+	brand := strings.Join(strings.Fields(request.Brand), " ")
+	if brand != "" {
+		query["brand"] = bson.M{"$regex": `^\s*` + text.AccentInsensitivePattern(brand) + `\s*$`, "$options": "i"}
+	}
+
+	raw, err := s.statsRepository.FindStats(ctx, query, kmBoundaries)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	setRangeMax(raw.Kilometers, kmBoundaries)
+
+	catalogStats := &domain.CatalogStats{
+		From:            from.Format(statsDateLayout),
+		To:              to.Format(statsDateLayout),
+		Brand:           brand,
+		ListingsPerDay:  fillPeriods(raw.PerDay, from, end, dayPeriod),
+		ListingsPerWeek: fillPeriods(raw.PerWeek, from, end, weekPeriod),
+		PriceByBrand:    make([]domain.PriceStats, 0, len(raw.ByBrand)),
+		PriceByYear:     make([]domain.PriceStats, 0, len(raw.ByYear)),
+		Kilometers:      raw.Kilometers,
+	}
+
+	for _, status := range raw.Status {
+		catalogStats.Total += status.Count
+		if status.Active {
+			catalogStats.Active += status.Count
+		} else {
+			catalogStats.Inactive += status.Count
+		}
+	}
+
+	for _, group := range raw.ByBrand {
+		catalogStats.PriceByBrand = append(catalogStats.PriceByBrand, priceStats(group.Name, group.Prices))
+	}
+
+	// Brands with more listings first
+	sort.SliceStable(catalogStats.PriceByBrand, func(i, j int) bool {
+		if catalogStats.PriceByBrand[i].Count != catalogStats.PriceByBrand[j].Count {
+			return catalogStats.PriceByBrand[i].Count > catalogStats.PriceByBrand[j].Count
+		}
+		return catalogStats.PriceByBrand[i].Group < catalogStats.PriceByBrand[j].Group
+	})
+
+	for _, group := range raw.ByYear {
+		catalogStats.PriceByYear = append(catalogStats.PriceByYear, priceStats(strconv.Itoa(group.Year), group.Prices))
+	}
+
+	response := &domain.StatsResponseSuccess{Success: true, Data: catalogStats}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return response, nil
+}
+
+// statsRange interpreta from y to en hora de Colombia. Sin to se usa hoy y sin from los 30 dias anteriores a to.
+// Retorna false si alguna fecha no tiene el formato YYYY-MM-DD, si from es posterior a to o si el rango supera statsMaxDays
+func statsRange(request domain.StatsRequest, now time.Time) (time.Time, time.Time, bool) {
+	to := paperwork.StartOfDay(now)
+	if request.To != "" {
+		parsed, err := time.ParseInLocation(statsDateLayout, request.To, paperwork.Colombia)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(statsDefaultDays - 1))
+	if request.From != "" {
+		parsed, err := time.ParseInLocation(statsDateLayout, request.From, paperwork.Colombia)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		from = parsed
+	}
+
+	if from.After(to) || paperwork.DaysUntil(to, from) >= statsMaxDays {
+		return time.Time{}, time.Time{}, false
+	}
+
+	return from, to, true
+}
+
+func dayPeriod(day time.Time) string {
+	return day.Format(statsDateLayout)
+}
+
+// weekPeriod usa la semana ISO con el mismo formato que %G-W%V de Mongo
+func weekPeriod(day time.Time) string {
+	year, week := day.ISOWeek()
+	return fmt.Sprintf("%d-W%02d", year, week)
+}
+
+// fillPeriods recorre los dias del rango [from, end) para que los periodos sin publicaciones aparezcan en 0
+func fillPeriods(counts []domain.FacetCount, from, end time.Time, period func(time.Time) string) []domain.PeriodCount {
+	byPeriod := map[string]int64{}
+	for _, count := range counts {
+		byPeriod[count.Value] = count.Count
+	}
+
+	periods := []domain.PeriodCount{}
+	for day := from; day.Before(end); day = day.AddDate(0, 0, 1) {
+		key := period(day)
+		if len(periods) > 0 && periods[len(periods)-1].Period == key {
+			continue
+		}
+		periods = append(periods, domain.PeriodCount{Period: key, Count: byPeriod[key]})
+	}
+
+	return periods
+}
+
+// priceStats calcula el promedio y la mediana de los precios de un grupo
+func priceStats(group string, prices []int64) domain.PriceStats {
+	values := make([]float64, 0, len(prices))
+	for _, price := range prices {
+		values = append(values, float64(price))
+	}
+
+	summary := stats.Summarize(values)
+
+	return domain.PriceStats{
+		Group:   group,
+		Count:   int64(summary.Count),
+		Average: int64(math.Round(summary.Mean)),
+		Median:  int64(math.Round(summary.Median)),
+	}
+}
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"math"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/stats"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Niveles de confianza de la valoracion
+const (
+	confidenceHigh   = "high"
+	confidenceMedium = "medium"
+	confidenceLow    = "low"
+)
+
+const (
+	// valuationMinSample es la cantidad minima de motos comparables para estimar un precio
+	valuationMinSample = 3
+	// valuationYearWindow es la diferencia de años de las motos comparables
+	valuationYearWindow = 3
+	// valuationKmUnit es la unidad de km en la que se expresa la depreciacion
+	valuationKmUnit = 1000
+)
+
+type getValuation struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewGetValuation(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *getValuation {
+	return &getValuation{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// valuationSample es el precio de una moto comparable con su año y kilometraje
+type valuationSample struct {
+	price float64
+	year  float64
+	km    float64
+}
+
+func (s *getValuation) Execute(ctx context.Context, request domain.ValuationRequest, pathRequest string) (*domain.ValuationResponseSuccess, *domain.ResponseHttpError) {
+	cacheKey := valuationCachePrefix + pathRequest
+	if cached, ok := s.cacheRepository.GetCached(cacheKey); ok {
+		if resp, ok := cached.(*domain.ValuationResponseSuccess); ok {
+			return resp, nil
+		}
+	}
+
+	// Active and sold (inactive) listings are comparable as long as they were reviewed,
+	// duplicated listings are left out so the same bike is not counted twice
+	filter := bson.M{
+		"reviewed":     true,
+		"duplicate_of": nil,
+		"price":        bson.M{"$gt": 0},
+		"brand":        bson.M{"$regex": "^" + text.AccentInsensitivePattern(request.Brand) + "$", "$options": "i"},
+		"model":        bson.M{"$regex": "^" + text.AccentInsensitivePattern(request.Model) + "$", "$options": "i"},
+	}
+
+	samples, err := s.findSamples(ctx, filter, request.Year)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	valuation, ok := estimateValue(samples, float64(request.Year), float64(request.Km))
+	if !ok {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorNotEnoughComparables, nil)
+	}
+
+	valuation.Brand = request.Brand
+	valuation.Model = request.Model
+	valuation.Year = request.Year
+	valuation.Km = request.Km
+
+	response := &domain.ValuationResponseSuccess{Success: true, Data: valuation}
+
+	s.cacheRepository.SetCached(cacheKey, response)
+
+	return response, nil
+}
+
+// findSamples busca las motos comparables de años cercanos y, si son muy pocas, de todos los años del modelo
+func (s *getValuation) findSamples(ctx context.Context, filter bson.M, year int64) ([]valuationSample, *errorBikes.WrapperError) {
+	findOpts := options.Find().SetProjection(bson.D{
+		{Key: "price", Value: 1},
+		{Key: "year_model", Value: 1},
+		{Key: "km", Value: 1},
+	})
+
+	if year > 0 {
+		yearFilter := bson.M{"year_model": bson.M{"$gte": year - valuationYearWindow, "$lte": year + valuationYearWindow}}
+		bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{"$and": bson.A{filter, yearFilter}}, findOpts)
+		if err != nil {
+			return nil, err
+		}
+		if len(bikes) >= valuationMinSample {
+			return toSamples(bikes), nil
+		}
+	}
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, filter, findOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toSamples(bikes), nil
+}
+
+func toSamples(bikes []*domain.Bike) []valuationSample {
+	samples := make([]valuationSample, 0, len(bikes))
+	for _, bike := range bikes {
+		samples = append(samples, valuationSample{price: float64(bike.Price), year: float64(bike.YearModel), km: float64(bike.Kilometers)})
+	}
+	return samples
+}
+
+// estimateValue estima el precio justo de una moto del segmento: parte de la mediana de los precios
+// (sin valores atipicos) y la ajusta por la diferencia de año y km con la depreciacion de una regresion.
+// year o km en 0 se toman como la mediana del segmento
+func estimateValue(samples []valuationSample, year, km float64) (*domain.Valuation, bool) {
+	samples = withoutOutliers(samples)
+	if len(samples) < valuationMinSample {
+		return nil, false
+	}
+
+	prices := make([]float64, 0, len(samples))
+	years := make([]float64, 0, len(samples))
+	kms := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		prices = append(prices, sample.price)
+		years = append(years, sample.year)
+		kms = append(kms, sample.km)
+	}
+
+	priceSummary := stats.Summarize(prices)
+	medianYear := stats.Summarize(years).Median
+	medianKm := stats.Summarize(kms).Median
+
+	if year <= 0 {
+		year = medianYear
+	}
+	if km <= 0 {
+		km = medianKm
+	}
+
+	perYear, perKm := depreciation(samples, medianYear, medianKm)
+
+	estimate := priceSummary.Median + perYear*(year-medianYear) - perKm*(km-medianKm)/valuationKmUnit
+	estimate = math.Max(estimate, 0)
+	halfRange := priceSummary.IQR() / 2
+
+	return &domain.Valuation{
+		Estimate:   int64(math.Round(estimate)),
+		Low:        int64(math.Round(math.Max(estimate-halfRange, 0))),
+		High:       int64(math.Round(estimate + halfRange)),
+		Median:     int64(math.Round(priceSummary.Median)),
+		Q1:         int64(math.Round(priceSummary.Q1)),
+		Q3:         int64(math.Round(priceSummary.Q3)),
+		IQR:        int64(math.Round(priceSummary.IQR())),
+		SampleSize: int64(len(samples)),
+		Confidence: confidence(len(samples), priceSummary),
+		Depreciation: domain.Depreciation{
+			PerYear:   int64(math.Round(perYear)),
+			Per1000Km: int64(math.Round(perKm)),
+		},
+	}, true
+}
+
+// depreciation ajusta precio = b0 + b1*año + b2*km/1000 y retorna cuanto sube el precio por año mas nuevo
+// y cuanto baja por cada 1000 km. Si los datos no alcanzan para ambas variables se ajusta solo una,
+// y las pendientes con signo contrario al esperado (ruido de pocos datos) se descartan
+func depreciation(samples []valuationSample, medianYear, medianKm float64) (float64, float64) {
+	prices := make([]float64, 0, len(samples))
+	both := make([][]float64, 0, len(samples))
+	onlyYear := make([][]float64, 0, len(samples))
+	onlyKm := make([][]float64, 0, len(samples))
+	for _, sample := range samples {
+		year := sample.year - medianYear
+		km := (sample.km - medianKm) / valuationKmUnit
+		prices = append(prices, sample.price)
+		both = append(both, []float64{year, km})
+		onlyYear = append(onlyYear, []float64{year})
+		onlyKm = append(onlyKm, []float64{km})
+	}
+
+	perYear, perKm := 0.0, 0.0
+	if coefficients, err := stats.LinearRegression(both, prices); err == nil {
+		perYear, perKm = coefficients[1], -coefficients[2]
+	} else if coefficients, err := stats.LinearRegression(onlyYear, prices); err == nil {
+		perYear = coefficients[1]
+	} else if coefficients, err := stats.LinearRegression(onlyKm, prices); err == nil {
+		perKm = -coefficients[1]
+	}
+
+	return math.Max(perYear, 0), math.Max(perKm, 0)
+}
+
+// withoutOutliers descarta los precios por fuera de 1.5 veces el rango intercuartil
+func withoutOutliers(samples []valuationSample) []valuationSample {
+	prices := make([]float64, 0, len(samples))
+	for _, sample := range samples {
+		prices = append(prices, sample.price)
+	}
+
+	summary := stats.Summarize(prices)
+	lower := summary.Q1 - 1.5*summary.IQR()
+	upper := summary.Q3 + 1.5*summary.IQR()
+
+	filtered := make([]valuationSample, 0, len(samples))
+	for _, sample := range samples {
+		if sample.price >= lower && sample.price <= upper {
+			filtered = append(filtered, sample)
+		}
+	}
+
+	return filtered
+}
+
+// confidence califica la valoracion segun la cantidad de motos y que tan dispersos son sus precios
+func confidence(sampleSize int, summary stats.Summary) string {
+	dispersion := 1.0
+	if summary.Median > 0 {
+		dispersion = summary.IQR() / summary.Median
+	}
+
+	switch {
+	case sampleSize >= 20 && dispersion <= 0.25:
+		return confidenceHigh
+	case sampleSize >= 8 && dispersion <= 0.5:
+		return confidenceMedium
+	default:
+		return confidenceLow
+	}
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/Bikes2Road/bikes-compass/utils/stats"
+)
+
+// linearSamples arma motos cuyo precio baja exactamente 1.000.000 por año y 100.000 por cada 1000 km
+func linearSamples(years []float64, kms []float64) []valuationSample {
+	samples := []valuationSample{}
+	for _, year := range years {
+		for _, km := range kms {
+			price := 20_000_000 + 1_000_000*(year-2020) - 100_000*(km-10_000)/1000
+			samples = append(samples, valuationSample{price: price, year: year, km: km})
+		}
+	}
+	return samples
+}
+
+func TestEstimateValue(t *testing.T) {
+	years := []float64{2018, 2019, 2020, 2021, 2022}
+	kms := []float64{5_000, 10_000, 15_000}
+	linear := linearSamples(years, kms)
+
+	withOutlier := append(linearSamples(years, kms), valuationSample{price: 200_000_000, year: 2020, km: 10_000})
+
+	// Prices that go up with the km, the km slope has the wrong sign and must be discarded
+	risingKm := []valuationSample{
+		{price: 10_000_000, year: 2020, km: 1_000},
+		{price: 11_000_000, year: 2020, km: 5_000},
+		{price: 12_000_000, year: 2020, km: 9_000},
+		{price: 13_000_000, year: 2020, km: 13_000},
+	}
+
+	tests := []struct {
+		name          string
+		samples       []valuationSample
+		year, km      float64
+		wantOK        bool
+		wantEstimate  int64
+		wantPerYear   int64
+		wantPer1000Km int64
+		wantSample    int64
+	}{
+		{
+			name:    "not enough bikes",
+			samples: linear[:2],
+			year:    2020,
+			km:      10_000,
+		},
+		{
+			name:          "newer and with less km than the median",
+			samples:       linear,
+			year:          2021,
+			km:            5_000,
+			wantOK:        true,
+			wantEstimate:  21_500_000,
+			wantPerYear:   1_000_000,
+			wantPer1000Km: 100_000,
+			wantSample:    15,
+		},
+		{
+			name:          "year and km default to the median",
+			samples:       linear,
+			wantOK:        true,
+			wantEstimate:  20_000_000,
+			wantPerYear:   1_000_000,
+			wantPer1000Km: 100_000,
+			wantSample:    15,
+		},
+		{
+			name:          "outlier left out",
+			samples:       withOutlier,
+			year:          2021,
+			km:            5_000,
+			wantOK:        true,
+			wantEstimate:  21_500_000,
+			wantPerYear:   1_000_000,
+			wantPer1000Km: 100_000,
+			wantSample:    15,
+		},
+		{
+			name:         "wrong sign slope discarded",
+			samples:      risingKm,
+			year:         2020,
+			km:           20_000,
+			wantOK:       true,
+			wantEstimate: 11_500_000,
+			wantSample:   4,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := estimateValue(tt.samples, tt.year, tt.km)
+			if ok != tt.wantOK {
+				t.Fatalf("estimateValue() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+
+			if got.Estimate != tt.wantEstimate {
+				t.Errorf("Estimate = %d, want %d", got.Estimate, tt.wantEstimate)
+			}
+			if got.Depreciation.PerYear != tt.wantPerYear || got.Depreciation.Per1000Km != tt.wantPer1000Km {
+				t.Errorf("Depreciation = %+v, want per year %d and per 1000 km %d", got.Depreciation, tt.wantPerYear, tt.wantPer1000Km)
+			}
+			if got.SampleSize != tt.wantSample {
+				t.Errorf("SampleSize = %d, want %d", got.SampleSize, tt.wantSample)
+			}
+			if got.Low > got.Estimate || got.High < got.Estimate {
+				t.Errorf("range %d - %d does not contain the estimate %d", got.Low, got.High, got.Estimate)
+			}
+		})
+	}
+}
+
+func TestConfidence(t *testing.T) {
+	tests := []struct {
+		name       string
+		sampleSize int
+		median     float64
+		iqr        float64
+		want       string
+	}{
+		{"many bikes with close prices", 25, 20_000_000, 4_000_000, confidenceHigh},
+		{"many bikes with dispersed prices", 25, 20_000_000, 8_000_000, confidenceMedium},
+		{"few bikes", 10, 20_000_000, 2_000_000, confidenceMedium},
+		{"very few bikes", 5, 20_000_000, 1_000_000, confidenceLow},
+		{"very dispersed prices", 25, 20_000_000, 15_000_000, confidenceLow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := stats.Summary{Count: tt.sampleSize, Median: tt.median, Q1: tt.median - tt.iqr/2, Q3: tt.median + tt.iqr/2}
+			if got := confidence(tt.sampleSize, summary); got != tt.want {
+				t.Errorf("confidence(%d, %+v) = %s, want %s", tt.sampleSize, summary, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+)
+
+// presignPhotosLimit completa las urls firmadas de las fotos de varias motos
+// con un maximo de workers llamadas simultaneas a R2
+func presignPhotosLimit(ctx context.Context, r2Repository ports.R2Repository, photos [][][]domain.Photo, expireTime time.Duration, workers int) {
+	jobs := make(chan *domain.Photo)
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for photo := range jobs {
+				url, err := r2Repository.GetPresignedURL(ctx, photo.Key, expireTime)
+				if err != nil {
+					// If error, set empty string and continue
+					photo.Url = ""
+					continue
+				}
+				photo.Url = url
+			}
+		}()
+	}
+
+	for _, groups := range photos {
+		for i := range groups {
+			for j := range groups[i] {
+				jobs <- &groups[i][j]
+			}
+		}
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+// presignPhotos completa la url firmada de cada foto, dejandola vacia si R2 falla
+func presignPhotos(ctx context.Context, r2Repository ports.R2Repository, photos [][]domain.Photo, expireTime time.Duration) {
+	var wg sync.WaitGroup
+	for i := range photos {
+		for j := range photos[i] {
+			wg.Add(1)
+			go func(i, j int) {
+				defer wg.Done()
+				url, err := r2Repository.GetPresignedURL(ctx, photos[i][j].Key, expireTime)
+				if err != nil {
+					// If error, set empty string and continue
+					photos[i][j].Url = ""
+					return
+				}
+				photos[i][j].Url = url
+			}(i, j)
+		}
+	}
+	wg.Wait()
+}
@@ -2,46 +2,95 @@ package services
 
 import (
 	"context"
+	"log"
+	"sync"
+	"time"
 
 	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
 	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
-	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
 	"go.mongodb.org/mongo-driver/v2/bson"
 	"go.mongodb.org/mongo-driver/v2/mongo/options"
 )
 
+// SuggestRefreshTime es cada cuanto se recarga el indice del autocompletado
+const SuggestRefreshTime = 10 * time.Minute
+
+const (
+	placeHolderLimit = 5
+	// suggestRetryTime es el tiempo minimo entre cargas del indice pedidas por las consultas mientras este vacio
+	suggestRetryTime = 30 * time.Second
+)
+
 type placeHolder struct {
 	mongoRepository ports.MongoRepository
+	index           *suggestIndex
+	// refreshMu evita que se carguen varios indices a la vez
+	refreshMu   sync.Mutex
+	lastRefresh time.Time
 }
 
+// NewPlaceHolder crea el servicio de autocompletado con el indice en memoria vacio,
+// el indice se carga con Refresh
 func NewPlaceHolder(mongoRepository ports.MongoRepository) *placeHolder {
 	return &placeHolder{
 		mongoRepository: mongoRepository,
+		index:           newSuggestIndex(),
 	}
 }
 
 func (s *placeHolder) Execute(ctx context.Context, requestPlaceHolder domain.PlaceHolderRequest) (*domain.PlaceHolderResponseSuccess, *domain.ResponseHttpError) {
-	name := requestPlaceHolder.NameByke
+	if s.index.Empty() {
+		// The index is loaded right after startup, try once more before answering
+		s.refreshIfEmpty(ctx)
+	}
 
-	query := bson.M{}
+	suggestions := s.index.Search(requestPlaceHolder.NameByke, placeHolderLimit)
 
-	query["full_name"] = bson.M{"$regex": name, "$options": "i"}
+	response := &domain.PlaceHolderResponseSuccess{Success: true, Data: suggestions, Total: int64(len(suggestions))}
+
+	return response, nil
+}
+
+// refreshIfEmpty carga el indice si sigue vacio. Las consultas que llegan mientras se carga esperan
+// esa misma carga y, si no hay motos, solo se vuelve a intentar despues de suggestRetryTime
+func (s *placeHolder) refreshIfEmpty(ctx context.Context) {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	if !s.index.Empty() || time.Since(s.lastRefresh) < suggestRetryTime {
+		return
+	}
+
+	s.load(ctx)
+}
+
+// Refresh recarga el indice con las motos publicadas
+func (s *placeHolder) Refresh(ctx context.Context) {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+
+	s.load(ctx)
+}
+
+// load carga los nombres, marcas y modelos de las motos publicadas en el indice
+func (s *placeHolder) load(ctx context.Context) {
+	s.lastRefresh = time.Now()
+
+	query := listedQuery()
 
 	fields := bson.D{
 		{Key: "full_name", Value: 1},
+		{Key: "brand", Value: 1},
+		{Key: "model", Value: 1},
 	}
 
-	findOpts := options.Find().SetProjection(fields).SetLimit(5)
+	findOpts := options.Find().SetProjection(fields)
 
 	names, err := s.mongoRepository.FindNames(ctx, query, findOpts)
-
 	if err != nil {
-		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+		log.Printf("error refreshing placeholder index: %v", err.Message)
+		return
 	}
 
-	totalNames := len(names)
-
-	response := &domain.PlaceHolderResponseSuccess{Success: true, Data: names, Total: int64(totalNames)}
-
-	return response, nil
+	s.index.Load(names)
 }
@@ -0,0 +1,154 @@
+package services
+
+import (
+	"context"
+	"math"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+// Limites del porcentaje frente al precio de mercado de cada calificacion
+const (
+	dealGreatMax = -15.0
+	dealGoodMax  = -5.0
+	dealFairMax  = 5.0
+)
+
+type refreshDealScores struct {
+	mongoRepository ports.MongoRepository
+}
+
+// NewRefreshDealScores crea el servicio que califica el precio de cada moto frente a su segmento
+func NewRefreshDealScores(mongoRepository ports.MongoRepository) *refreshDealScores {
+	return &refreshDealScores{
+		mongoRepository: mongoRepository,
+	}
+}
+
+// dealListing es una moto revisada con los datos que necesita la calificacion
+type dealListing struct {
+	bike   *domain.Bike
+	sample valuationSample
+}
+
+// Execute agrupa las motos revisadas por marca y modelo, estima el precio de mercado de cada moto activa
+// con las demas de su segmento y guarda deal_score y deal_percentage solo cuando cambian
+func (s *refreshDealScores) Execute(ctx context.Context) (*domain.BackfillResult, *errorBikes.WrapperError) {
+	findOpts := options.Find().SetProjection(bson.D{
+		{Key: "hash_byke", Value: 1},
+		{Key: "brand", Value: 1},
+		{Key: "model", Value: 1},
+		{Key: "year_model", Value: 1},
+		{Key: "km", Value: 1},
+		{Key: "price", Value: 1},
+		{Key: "active", Value: 1},
+		{Key: "deal_score", Value: 1},
+		{Key: "deal_percentage", Value: 1},
+		{Key: "duplicate_of", Value: 1},
+	})
+
+	bikes, err := s.mongoRepository.FindBikes(ctx, bson.M{"reviewed": true, "price": bson.M{"$gt": 0}}, findOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	segments := map[string][]dealListing{}
+	for _, bike := range bikes {
+		key := text.Fold(bike.Brand) + "|" + text.Fold(bike.Model)
+		segments[key] = append(segments[key], dealListing{
+			bike:   bike,
+			sample: valuationSample{price: float64(bike.Price), year: float64(bike.YearModel), km: float64(bike.Kilometers)},
+		})
+	}
+
+	result := &domain.BackfillResult{}
+	for _, listings := range segments {
+		for i, listing := range listings {
+			if !listing.bike.Active {
+				continue
+			}
+			result.Processed++
+
+			score, percentage := "", (*float64)(nil)
+			if valuation, ok := estimateValue(segmentSamples(listings, i), listing.sample.year, listing.sample.km); ok && valuation.Estimate > 0 {
+				value := math.Round((listing.sample.price-float64(valuation.Estimate))/float64(valuation.Estimate)*1000) / 10
+				score, percentage = dealScore(value), &value
+			}
+
+			if score == listing.bike.DealScore && sameFloat(percentage, listing.bike.DealPercentage) {
+				result.Skipped++
+				continue
+			}
+
+			update := bson.M{"deal_score": nilIfEmpty(score), "deal_percentage": percentage}
+			// Para que las busquedas guardadas con filtro deal la vuelvan a comparar con su nueva calificacion
+			if score != listing.bike.DealScore {
+				update[savedSearchEvaluatedField] = nil
+			}
+			if err := s.mongoRepository.UpdateByHash(ctx, listing.bike.HashByke, update); err != nil {
+				return result, err
+			}
+			result.Updated++
+		}
+	}
+
+	return result, nil
+}
+
+// segmentSamples retorna las demas motos del segmento con años cercanos, o todas si son muy pocas.
+// Los duplicados se califican pero no son muestra, para no contar dos veces la misma moto
+func segmentSamples(listings []dealListing, current int) []valuationSample {
+	year := listings[current].sample.year
+
+	near := []valuationSample{}
+	all := []valuationSample{}
+	for i, listing := range listings {
+		if i == current || listing.bike.DuplicateOf != "" {
+			continue
+		}
+		all = append(all, listing.sample)
+		if math.Abs(listing.sample.year-year) <= valuationYearWindow {
+			near = append(near, listing.sample)
+		}
+	}
+
+	if len(near) >= valuationMinSample {
+		return near
+	}
+
+	return all
+}
+
+// dealScore califica el porcentaje del precio frente al precio de mercado
+func dealScore(percentage float64) string {
+	switch {
+	case percentage <= dealGreatMax:
+		return domain.DealGreat
+	case percentage <= dealGoodMax:
+		return domain.DealGood
+	case percentage <= dealFairMax:
+		return domain.DealFair
+	default:
+		return domain.DealHigh
+	}
+}
+
+// sameFloat compara dos valores opcionales, iguales si ambos son nil o tienen el mismo valor
+func sameFloat(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func nilIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
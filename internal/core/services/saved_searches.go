@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+	"go.mongodb.org/mongo-driver/v2/mongo/options"
+)
+
+type createSavedSearch struct {
+	savedSearchRepository ports.SavedSearchRepository
+}
+
+func NewCreateSavedSearch(savedSearchRepository ports.SavedSearchRepository) *createSavedSearch {
+	return &createSavedSearch{
+		savedSearchRepository: savedSearchRepository,
+	}
+}
+
+func (s *createSavedSearch) Execute(ctx context.Context, request domain.CreateSavedSearchRequest) (*domain.SavedSearchResponseSuccess, *domain.ResponseHttpError) {
+	search := &domain.SavedSearch{
+		ID:        bson.NewObjectID().Hex(),
+		OwnerID:   request.OwnerID,
+		Filters:   request.Filters,
+		CreatedAt: time.Now().Unix(),
+	}
+
+	if err := s.savedSearchRepository.Insert(ctx, search); err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	return &domain.SavedSearchResponseSuccess{Success: true, Data: search}, nil
+}
+
+type getSavedSearchMatches struct {
+	savedSearchRepository ports.SavedSearchRepository
+}
+
+func NewGetSavedSearchMatches(savedSearchRepository ports.SavedSearchRepository) *getSavedSearchMatches {
+	return &getSavedSearchMatches{
+		savedSearchRepository: savedSearchRepository,
+	}
+}
+
+// Execute retorna las coincidencias registradas despues de after, para que el cliente
+// consulte periodicamente enviando el id de la ultima que recibio.
+// Una busqueda de otro dueño responde igual que una que no existe
+func (s *getSavedSearchMatches) Execute(ctx context.Context, request domain.SavedSearchMatchesRequest) (*domain.SavedSearchMatchesResponseSuccess, *domain.ResponseHttpError) {
+	search, err := s.savedSearchRepository.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	if search.OwnerID != request.OwnerID {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorSavedSearchNotFound, nil)
+	}
+
+	filter := bson.M{"search_id": request.ID}
+	if request.After != "" {
+		after, errHex := bson.ObjectIDFromHex(request.After)
+		if errHex != nil {
+			return nil, errorBikes.MapErrorResponse(errorBikes.ErrorInvalidQueryParams, errHex)
+		}
+		filter["_id"] = bson.M{"$gt": after}
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(request.Cant)
+
+	matches, err := s.savedSearchRepository.FindMatches(ctx, filter, findOpts)
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	return &domain.SavedSearchMatchesResponseSuccess{Success: true, Data: matches, Total: int64(len(matches))}, nil
+}
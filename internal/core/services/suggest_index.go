@@ -0,0 +1,238 @@
+package services
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+)
+
+// Categorias de las sugerencias del autocompletado, en orden de prioridad
+const (
+	suggestionBrand    = "brand"
+	suggestionModel    = "model"
+	suggestionFullName = "full_name"
+)
+
+var categoryPriority = map[string]int{
+	suggestionBrand:    0,
+	suggestionModel:    1,
+	suggestionFullName: 2,
+}
+
+type suggestEntry struct {
+	value      string
+	normalized string
+	category   string
+	count      int64
+}
+
+// suggestToken relaciona una palabra (o el texto completo) con su entrada
+type suggestToken struct {
+	token string
+	entry int
+}
+
+type suggestMatch struct {
+	entry    int
+	distance int
+}
+
+// suggestIndex es un indice en memoria de marcas, modelos y nombres distintos
+// que resuelve el autocompletado por prefijo y por distancia de edicion
+type suggestIndex struct {
+	mutex   sync.RWMutex
+	entries []suggestEntry
+	tokens  []suggestToken
+}
+
+func newSuggestIndex() *suggestIndex {
+	return &suggestIndex{}
+}
+
+// Load reconstruye el indice con los nombres de las motos publicadas
+func (idx *suggestIndex) Load(bikes []*domain.BykeName) {
+	entries := []suggestEntry{}
+	positions := map[string]int{}
+
+	add := func(value, category string) {
+		value = strings.TrimSpace(value)
+		normalized := normalizeSuggestion(value)
+		if normalized == "" {
+			return
+		}
+
+		key := category + "|" + normalized
+		if pos, ok := positions[key]; ok {
+			entries[pos].count++
+			return
+		}
+
+		positions[key] = len(entries)
+		entries = append(entries, suggestEntry{value: value, normalized: normalized, category: category, count: 1})
+	}
+
+	for _, bike := range bikes {
+		if bike == nil {
+			continue
+		}
+		add(bike.Brand, suggestionBrand)
+		add(bike.Model, suggestionModel)
+		add(bike.FullName, suggestionFullName)
+	}
+
+	tokens := []suggestToken{}
+	for i, entry := range entries {
+		tokens = append(tokens, suggestToken{token: entry.normalized, entry: i})
+		words := strings.Fields(entry.normalized)
+		if len(words) > 1 {
+			for _, word := range words {
+				tokens = append(tokens, suggestToken{token: word, entry: i})
+			}
+		}
+	}
+
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].token < tokens[j].token
+	})
+
+	idx.mutex.Lock()
+	defer idx.mutex.Unlock()
+	idx.entries = entries
+	idx.tokens = tokens
+}
+
+// Empty indica si el indice aun no tiene datos cargados
+func (idx *suggestIndex) Empty() bool {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+	return len(idx.entries) == 0
+}
+
+// Search retorna hasta limit sugerencias para el texto buscado.
+// Primero las que empiezan por el texto y despues las que estan a pocas ediciones
+func (idx *suggestIndex) Search(query string, limit int) []domain.PlaceHolderSuggestion {
+	idx.mutex.RLock()
+	defer idx.mutex.RUnlock()
+
+	query = normalizeSuggestion(query)
+	matches := map[int]int{}
+
+	if query == "" {
+		for i := range idx.entries {
+			matches[i] = 0
+		}
+	} else {
+		start := sort.Search(len(idx.tokens), func(i int) bool {
+			return idx.tokens[i].token >= query
+		})
+		for i := start; i < len(idx.tokens) && strings.HasPrefix(idx.tokens[i].token, query); i++ {
+			matches[idx.tokens[i].entry] = 0
+		}
+
+		if maxEdits := allowedEdits(query); maxEdits > 0 {
+			for _, token := range idx.tokens {
+				if _, ok := matches[token.entry]; ok {
+					continue
+				}
+				if distance := prefixDistance(query, token.token); distance <= maxEdits {
+					if current, ok := matches[token.entry]; !ok || distance < current {
+						matches[token.entry] = distance
+					}
+				}
+			}
+		}
+	}
+
+	ranked := make([]suggestMatch, 0, len(matches))
+	for entry, distance := range matches {
+		ranked = append(ranked, suggestMatch{entry: entry, distance: distance})
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		a, b := idx.entries[ranked[i].entry], idx.entries[ranked[j].entry]
+		if ranked[i].distance != ranked[j].distance {
+			return ranked[i].distance < ranked[j].distance
+		}
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		if categoryPriority[a.category] != categoryPriority[b.category] {
+			return categoryPriority[a.category] < categoryPriority[b.category]
+		}
+		return a.normalized < b.normalized
+	})
+
+	suggestions := []domain.PlaceHolderSuggestion{}
+	seen := map[string]bool{}
+	for _, match := range ranked {
+		entry := idx.entries[match.entry]
+		// The same text can be a brand and a full name, only the first one is kept
+		if seen[entry.normalized] {
+			continue
+		}
+		seen[entry.normalized] = true
+
+		suggestions = append(suggestions, domain.PlaceHolderSuggestion{
+			Value:    entry.value,
+			Category: entry.category,
+			Count:    entry.count,
+		})
+		if len(suggestions) == limit {
+			break
+		}
+	}
+
+	return suggestions
+}
+
+// allowedEdits define cuantos errores de digitacion se toleran segun el largo del texto
+func allowedEdits(query string) int {
+	switch length := len([]rune(query)); {
+	case length < 3:
+		return 0
+	case length < 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// prefixDistance calcula la menor distancia de Levenshtein entre el texto buscado
+// y cualquier prefijo del token, asi "yamha" queda a una edicion de "yamaha"
+func prefixDistance(query, token string) int {
+	a := []rune(query)
+	b := []rune(token)
+
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	best := previous[0]
+	for _, distance := range previous {
+		best = min(best, distance)
+	}
+
+	return best
+}
+
+// normalizeSuggestion deja el texto sin tildes, en minusculas y con espacios simples para comparar
+func normalizeSuggestion(value string) string {
+	return text.Fold(value)
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+)
+
+func testSuggestIndex() *suggestIndex {
+	bikes := []*domain.BykeName{}
+	add := func(times int, brand, model string) {
+		for i := 0; i < times; i++ {
+			bikes = append(bikes, &domain.BykeName{FullName: brand + " " + model, Brand: brand, Model: model})
+		}
+	}
+
+	add(3, "Yamaha", "MT-03")
+	add(1, "Yamaha", "MT-09")
+	add(1, "Yamaha", "XTZ 250")
+	add(2, "Honda", "CB 190R")
+	add(1, "Hero", "Hunk")
+	// Full name equal to the model, only one of them must be suggested
+	bikes = append(bikes, &domain.BykeName{FullName: "Duke 200", Brand: "KTM", Model: "Duke 200"}, nil)
+
+	index := newSuggestIndex()
+	index.Load(bikes)
+	return index
+}
+
+func TestSuggestIndexSearch(t *testing.T) {
+	index := testSuggestIndex()
+
+	yamaha := []domain.PlaceHolderSuggestion{
+		{Value: "Yamaha", Category: suggestionBrand, Count: 5},
+		{Value: "Yamaha MT-03", Category: suggestionFullName, Count: 3},
+		{Value: "Yamaha MT-09", Category: suggestionFullName, Count: 1},
+		{Value: "Yamaha XTZ 250", Category: suggestionFullName, Count: 1},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []domain.PlaceHolderSuggestion
+	}{
+		{
+			name:  "prefix ranked by count",
+			query: "yam",
+			limit: 5,
+			want:  yamaha,
+		},
+		{
+			name:  "typo within one edit",
+			query: "Yamha",
+			limit: 5,
+			want:  yamaha,
+		},
+		{
+			name:  "prefix matches before typos with more listings",
+			query: "hun",
+			limit: 5,
+			want: []domain.PlaceHolderSuggestion{
+				{Value: "Hunk", Category: suggestionModel, Count: 1},
+				{Value: "Hero Hunk", Category: suggestionFullName, Count: 1},
+				{Value: "Honda", Category: suggestionBrand, Count: 2},
+				{Value: "Honda CB 190R", Category: suggestionFullName, Count: 2},
+			},
+		},
+		{
+			name:  "word inside the name",
+			query: "190",
+			limit: 5,
+			want: []domain.PlaceHolderSuggestion{
+				{Value: "CB 190R", Category: suggestionModel, Count: 2},
+				{Value: "Honda CB 190R", Category: suggestionFullName, Count: 2},
+			},
+		},
+		{
+			name:  "same text as model and full name",
+			query: "duke",
+			limit: 5,
+			want: []domain.PlaceHolderSuggestion{
+				{Value: "Duke 200", Category: suggestionModel, Count: 1},
+			},
+		},
+		{
+			name:  "short query without typos",
+			query: "xy",
+			limit: 5,
+			want:  []domain.PlaceHolderSuggestion{},
+		},
+		{
+			name:  "empty query returns the most listed",
+			query: "  ",
+			limit: 2,
+			want: []domain.PlaceHolderSuggestion{
+				{Value: "Yamaha", Category: suggestionBrand, Count: 5},
+				{Value: "MT-03", Category: suggestionModel, Count: 3},
+			},
+		},
+		{
+			name:  "limit",
+			query: "yamaha",
+			limit: 2,
+			want:  yamaha[:2],
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := index.Search(tt.query, tt.limit)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Search(%q, %d) = %+v, want %+v", tt.query, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixDistance(t *testing.T) {
+	tests := []struct {
+		query string
+		token string
+		want  int
+	}{
+		{"yamaha", "yamaha", 0},
+		{"yam", "yamaha", 0},
+		{"yamha", "yamaha", 1},
+		{"yamaja", "yamaha", 1},
+		{"hinda", "honda", 1},
+		{"suzki", "suzuki", 1},
+		{"kawa", "honda", 4},
+	}
+
+	for _, tt := range tests {
+		if got := prefixDistance(tt.query, tt.token); got != tt.want {
+			t.Errorf("prefixDistance(%q, %q) = %d, want %d", tt.query, tt.token, got, tt.want)
+		}
+	}
+}
+
+func TestAllowedEdits(t *testing.T) {
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"mt", 0},
+		{"ktm", 1},
+		{"honda", 1},
+		{"yamaha", 2},
+		{"ñandú", 1},
+	}
+
+	for _, tt := range tests {
+		if got := allowedEdits(tt.query); got != tt.want {
+			t.Errorf("allowedEdits(%q) = %d, want %d", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/Bikes2Road/bikes-compass/internal/core/domain"
+	"github.com/Bikes2Road/bikes-compass/internal/core/ports"
+	errorBikes "github.com/Bikes2Road/bikes-compass/utils/error"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+// derivedSources son los campos de texto de los que se calcula cada grupo de campos derivados
+var derivedSources = []struct {
+	sources []string
+	derived []string
+}{
+	{sources: []string{"cylinder", "horse_power", "torque", "weight"}, derived: []string{"cylinder_cc", "horse_power_hp", "torque_nm", "weight_kg"}},
+	{sources: []string{"extras"}, derived: []string{"extras_tags"}},
+	{sources: []string{"date_soat"}, derived: []string{"soat_expires_at"}},
+	{sources: []string{"date_tecnico"}, derived: []string{"tecnico_expires_at"}},
+	{sources: []string{"location", "city_register"}, derived: []string{"geo"}},
+}
+
+type updateByke struct {
+	mongoRepository ports.MongoRepository
+	cacheRepository ports.CacheRepository[string, any]
+}
+
+func NewUpdateByke(mongoRepository ports.MongoRepository, cacheRepository ports.CacheRepository[string, any]) *updateByke {
+	return &updateByke{
+		mongoRepository: mongoRepository,
+		cacheRepository: cacheRepository,
+	}
+}
+
+// Execute aplica los campos enviados a la publicacion si sigue en la version leida por el cliente,
+// recalcula los campos derivados de los textos que cambiaron y limpia el cache de la moto y de las busquedas.
+// Si cambia active o reviewed sus copias dejan de estar marcadas como duplicadas de ella
+func (s *updateByke) Execute(ctx context.Context, hash string, version int, request domain.UpdateBykeRequest) (*domain.UpdateBykeResponseSuccess, *domain.ResponseHttpError) {
+	found, err := s.mongoRepository.FindBikes(ctx, bson.M{"hash_byke": hash})
+	if err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	if len(found) == 0 {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorBykeNotFound, fmt.Errorf("byke with hash %s not found", hash))
+	}
+
+	bike := found[0]
+	if bike.Version != version {
+		return nil, errorBikes.MapErrorResponse(errorBikes.ErrorVersionConflict, nil)
+	}
+
+	oldPrice := bike.Price
+	update := applyBykeUpdate(bike, request)
+	if len(update) == 0 {
+		return &domain.UpdateBykeResponseSuccess{Success: true, Data: bike}, nil
+	}
+
+	setDerivedFields(bike)
+	derivedValues := bson.M{
+		"cylinder_cc":        bike.CylinderCc,
+		"horse_power_hp":     bike.HorsePowerHp,
+		"torque_nm":          bike.TorqueNm,
+		"weight_kg":          bike.WeightKg,
+		"extras_tags":        bike.ExtrasTags,
+		"soat_expires_at":    bike.SoatExpiresAt,
+		"tecnico_expires_at": bike.TecnicoExpiresAt,
+		"geo":                bike.Geo,
+	}
+	for _, group := range derivedSources {
+		if !anyField(update, group.sources) {
+			continue
+		}
+		for _, field := range group.derived {
+			update[field] = derivedValues[field]
+		}
+	}
+
+	// Para que la evaluacion de busquedas guardadas la vuelva a comparar si queda activa y revisada
+	if anyField(update, []string{"active", "reviewed"}) {
+		update[savedSearchEvaluatedField] = nil
+	}
+
+	if err := s.mongoRepository.UpdateByHashVersion(ctx, hash, version, update); err != nil {
+		return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+	}
+
+	// Like on delete, the copies of a listing that stops being shown are shown again
+	// until the next duplicates detection groups them with the current state
+	if anyField(update, []string{"active", "reviewed"}) {
+		if err := s.mongoRepository.UpdateMany(ctx, bson.M{"duplicate_of": hash}, bson.M{"duplicate_of": nil}); err != nil {
+			return nil, errorBikes.MapErrorResponse(err.Type, err.Message)
+		}
+	}
+
+	invalidateBykeCache(s.cacheRepository, hash)
+
+	bike.Version = version + 1
+	if bike.Price != oldPrice {
+		bike.PreviousPrice = oldPrice
+		bike.PriceDropped = bike.Price < oldPrice
+	}
+
+	return &domain.UpdateBykeResponseSuccess{Success: true, Data: bike}, nil
+}
+
+// applyBykeUpdate copia a la moto los campos enviados y retorna los que cambiaron con su nombre en la coleccion
+func applyBykeUpdate(bike *domain.Bike, request domain.UpdateBykeRequest) bson.M {
+	update := bson.M{}
+
+	patchField(update, "ref", request.Ref, &bike.Ref)
+	patchField(update, "brand", trimmed(request.Brand), &bike.Brand)
+	patchField(update, "model", trimmed(request.Model), &bike.Model)
+	patchField(update, "full_name", trimmed(request.FullName), &bike.FullName)
+	patchField(update, "year_model", request.YearModel, &bike.YearModel)
+	patchField(update, "cylinder", request.Cylinder, &bike.Cylinder)
+	patchField(update, "engine", request.Engine, &bike.Engine)
+	patchField(update, "horse_power", request.HorsePower, &bike.HorsePower)
+	patchField(update, "torque", request.Torque, &bike.Torque)
+	patchField(update, "weight", request.Weight, &bike.Weight)
+	patchField(update, "km", request.Kilometers, &bike.Kilometers)
+	patchField(update, "price", request.Price, &bike.Price)
+	patchField(update, "location", trimmed(request.Location), &bike.Location)
+	patchField(update, "city_register", request.CityRegister, &bike.CityRegister)
+	patchField(update, "extras", request.Extras, &bike.Extras)
+	patchField(update, "description", request.Description, &bike.Description)
+	patchField(update, "date_soat", request.DateSoat, &bike.DateSoat)
+	patchField(update, "date_tecnico", request.DateTecnico, &bike.DateTecnico)
+	patchField(update, "page_instagram", request.PageInstagram, &bike.PageInstagram)
+	patchField(update, "url_post", request.UrlPost, &bike.UrlPost)
+	patchField(update, "photos", request.Photos, &bike.Photos)
+	patchField(update, "active", request.Active, &bike.Active)
+	patchField(update, "reviewed", request.Reviewed, &bike.Reviewed)
+
+	return update
+}
+
+// patchField asigna el valor enviado si es distinto al actual y lo agrega a update
+func patchField[T any](update bson.M, field string, value *T, current *T) {
+	if value == nil || reflect.DeepEqual(*value, *current) {
+		return
+	}
+	*current = *value
+	update[field] = *value
+}
+
+func trimmed(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmedValue := strings.TrimSpace(*value)
+	return &trimmedValue
+}
+
+func anyField(update bson.M, fields []string) bool {
+	for _, field := range fields {
+		if _, ok := update[field]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidateBykeCache elimina del cache el detalle e historial de la moto y todas las respuestas
+// calculadas con varias publicaciones (busquedas, similares, comparaciones, catalogos, valuacion y estadisticas)
+func invalidateBykeCache(cacheRepository ports.CacheRepository[string, any], hash string) {
+	exactKeys := append([]string{bykeCacheKey(hash), priceHistoryCacheKey(hash)}, listingsCacheKeys...)
+
+	cacheRepository.DeleteCachedFunc(func(key string) bool {
+		if slices.Contains(exactKeys, key) {
+			return true
+		}
+		for _, prefix := range listingsCachePrefixes {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		}
+		return false
+	})
+}
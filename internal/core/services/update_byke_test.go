@@ -0,0 +1,65 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+type mapCache map[string]any
+
+func (c mapCache) GetCached(key string) (any, bool) {
+	value, ok := c[key]
+	return value, ok
+}
+
+func (c mapCache) SetCached(key string, value any) { c[key] = value }
+
+func (c mapCache) SetCachedWithTTL(key string, value any, _ time.Duration) { c[key] = value }
+
+func (c mapCache) DeleteCachedFunc(match func(key string) bool) {
+	for key := range c {
+		if match(key) {
+			delete(c, key)
+		}
+	}
+}
+
+func (c mapCache) ClearCache() { clear(c) }
+
+func TestInvalidateBykeCache(t *testing.T) {
+	const hash = "abc123def456"
+	const other = "zzz999yyy888"
+
+	tests := []struct {
+		key     string
+		deleted bool
+	}{
+		{bykeCacheKey(hash), true},
+		{priceHistoryCacheKey(hash), true},
+		{searchCachePrefix + "/api/v1/bikes/search?brand=yamaha", true},
+		{facetsCachePrefix + "/api/v1/bikes/facets", true},
+		{similarCachePrefix + "/api/v1/bikes/byke/" + other + "/similar", true},
+		{compareCachePrefix + "/api/v1/bikes/compare?hashes=" + other, true},
+		{valuationCachePrefix + "/api/v1/bikes/valuation?brand=yamaha", true},
+		{statsCachePrefix + "/api/v1/bikes/stats|2026-01-01|2026-01-31", true},
+		{brandsCacheKey, true},
+		{brandModelsCacheKey("Yamaha"), true},
+		{locationsCacheKey, true},
+		{extraTagsCacheKey, true},
+		{bykeCacheKey(other), false},
+		{priceHistoryCacheKey(other), false},
+	}
+
+	cache := mapCache{}
+	for _, tt := range tests {
+		cache.SetCached(tt.key, true)
+	}
+
+	invalidateBykeCache(cache, hash)
+
+	for _, tt := range tests {
+		if _, ok := cache.GetCached(tt.key); ok == tt.deleted {
+			t.Errorf("key %q: deleted = %v, want %v", tt.key, !ok, tt.deleted)
+		}
+	}
+}
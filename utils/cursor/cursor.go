@@ -0,0 +1,83 @@
+package cursor
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+// Cursor representa la posicion de la ultima moto entregada en una busqueda
+// paginada por keyset: el ordenamiento usado, el valor del campo de orden y el hash_byke
+type Cursor struct {
+	Sort     string  `json:"s"`
+	Value    float64 `json:"v"`
+	HashByke string  `json:"h"`
+}
+
+var ErrInvalidCursor = errors.New("cursor is not valid")
+
+// Encode serializa el cursor y lo firma con HMAC-SHA256 para que sea opaco al cliente
+func Encode(secret []byte, c Cursor) (string, error) {
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	signature := base64.RawURLEncoding.EncodeToString(sign(secret, encodedPayload))
+
+	return encodedPayload + "." + signature, nil
+}
+
+// Decode valida la firma del cursor y retorna su contenido
+func Decode(secret []byte, token string) (*Cursor, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, sign(secret, encodedPayload)) {
+		return nil, ErrInvalidCursor
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(payload, &c); err != nil || c.HashByke == "" {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// Start retorna donde empieza la pagina pedida: con token el cursor validado contra el sort
+// (page se ignora y el skip es 0), sin token el skip de la pagina
+func Start(secret []byte, token, sort string, page, cant int64) (*Cursor, int64, error) {
+	if token == "" {
+		return nil, (page - 1) * cant, nil
+	}
+
+	c, err := Decode(secret, token)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if c.Sort != sort {
+		return nil, 0, ErrInvalidCursor
+	}
+
+	return c, 0, nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
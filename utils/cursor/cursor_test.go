@@ -0,0 +1,105 @@
+package cursor
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+var testSecret = []byte("test-secret")
+
+func TestEncodeDecode(t *testing.T) {
+	original := Cursor{Sort: "price_asc", Value: 25000000, HashByke: "abcd1234abcd"}
+
+	token, err := Encode(testSecret, original)
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	decoded, err := Decode(testSecret, token)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+
+	if *decoded != original {
+		t.Errorf("Decode() = %+v, want %+v", *decoded, original)
+	}
+}
+
+func TestDecodeTampered(t *testing.T) {
+	token, err := Encode(testSecret, Cursor{Sort: "newest", Value: 1731081212, HashByke: "abcd1234abcd"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	payload, signature, _ := strings.Cut(token, ".")
+
+	forged, err := Encode([]byte("other-secret"), Cursor{Sort: "newest", Value: 0, HashByke: "abcd1234abcd"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"payload changed", forgedPayload + "." + signature},
+		{"signature changed", payload + "." + strings.ToUpper(signature)},
+		{"signed with other secret", forged},
+		{"without signature", payload},
+		{"signature not base64", payload + ".%%%"},
+		{"empty", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(testSecret, tt.token); !errors.Is(err, ErrInvalidCursor) {
+				t.Errorf("Decode(%q) error = %v, want ErrInvalidCursor", tt.token, err)
+			}
+		})
+	}
+}
+
+func TestStart(t *testing.T) {
+	token, err := Encode(testSecret, Cursor{Sort: "price_asc", Value: 25000000, HashByke: "abcd1234abcd"})
+	if err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		sort       string
+		page       int64
+		wantCursor bool
+		wantSkip   int64
+		wantErr    bool
+	}{
+		{name: "page without cursor", sort: "price_asc", page: 3, wantSkip: 20},
+		{name: "first page", sort: "newest", page: 1, wantSkip: 0},
+		{name: "cursor ignores page", token: token, sort: "price_asc", page: 5, wantCursor: true, wantSkip: 0},
+		{name: "cursor of other sort", token: token, sort: "price_desc", page: 1, wantErr: true},
+		{name: "tampered cursor", token: token + "x", sort: "price_asc", page: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, skip, err := Start(testSecret, tt.token, tt.sort, tt.page, 10)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidCursor) {
+					t.Fatalf("Start() error = %v, want ErrInvalidCursor", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Start() error = %v", err)
+			}
+			if (c != nil) != tt.wantCursor {
+				t.Errorf("Start() cursor = %+v, want cursor %v", c, tt.wantCursor)
+			}
+			if skip != tt.wantSkip {
+				t.Errorf("Start() skip = %d, want %d", skip, tt.wantSkip)
+			}
+		})
+	}
+}
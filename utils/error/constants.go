@@ -7,23 +7,52 @@ import (
 const SuccessStatus = false
 
 const (
-	ErrorBadRequest         = "error_bad_request"
-	ErrorInvalidPage        = "error_invalid_page"
-	ErrorInvalidCant        = "error_invalid_cant"
-	ErrorInvalidStringBike  = "error_invalid_string_bike"
-	ErrorBikesNotFound      = "error_bikes_not_found"
-	ErrorBykeNotFound       = "error_byke_not_found"
-	ErrorUpdateByke         = "error_update_byke"
-	ErrorDeleteByke         = "error_delete_byke"
-	ErrorUnauthorized       = "error_unauthorized"
-	ErrorUnexpected         = "error_unexpected"
-	ErrorMongoFindAll       = "error_mongo_find_all"
-	ErrorMongoFind          = "error_mongo_find"
-	ErrorR2Url              = "error_r2_generating_url"
-	ErrorR2KeyEmpty         = "error_r2_key_empty"
-	ErrorInvalidQueryParams = "error_query_params_invalids"
-	ErrorInvalidPathParams  = "error_path_params_invalid"
-	ErrorInvalidPathParam   = "error_path_param_invalid"
+	ErrorBadRequest           = "error_bad_request"
+	ErrorInvalidPage          = "error_invalid_page"
+	ErrorInvalidCant          = "error_invalid_cant"
+	ErrorInvalidStringBike    = "error_invalid_string_bike"
+	ErrorBikesNotFound        = "error_bikes_not_found"
+	ErrorBykeNotFound         = "error_byke_not_found"
+	ErrorUpdateByke           = "error_update_byke"
+	ErrorDeleteByke           = "error_delete_byke"
+	ErrorUnauthorized         = "error_unauthorized"
+	ErrorUnexpected           = "error_unexpected"
+	ErrorMongoFindAll         = "error_mongo_find_all"
+	ErrorMongoFind            = "error_mongo_find"
+	ErrorMongoCount           = "error_mongo_count"
+	ErrorMongoAggregate       = "error_mongo_aggregate"
+	ErrorR2Url                = "error_r2_generating_url"
+	ErrorR2KeyEmpty           = "error_r2_key_empty"
+	ErrorR2GetObject          = "error_r2_getting_object"
+	ErrorInvalidQueryParams   = "error_query_params_invalids"
+	ErrorInvalidPathParams    = "error_path_params_invalid"
+	ErrorInvalidPathParam     = "error_path_param_invalid"
+	ErrorInvalidPriceRange    = "error_invalid_price_range"
+	ErrorInvalidYearRange     = "error_invalid_year_range"
+	ErrorInvalidKm            = "error_invalid_km"
+	ErrorInvalidSort          = "error_invalid_sort"
+	ErrorInvalidCursor        = "error_invalid_cursor"
+	ErrorInvalidSearchMode    = "error_invalid_search_mode"
+	ErrorMongoIndex           = "error_mongo_index"
+	ErrorInvalidLocation      = "error_invalid_location"
+	ErrorInvalidGeo           = "error_invalid_geo"
+	ErrorInvalidCompare       = "error_invalid_compare"
+	ErrorInvalidBatch         = "error_invalid_batch"
+	ErrorInvalidSavedSearch   = "error_invalid_saved_search"
+	ErrorSavedSearchNotFound  = "error_saved_search_not_found"
+	ErrorInvalidDeal          = "error_invalid_deal"
+	ErrorInvalidValuation     = "error_invalid_valuation"
+	ErrorNotEnoughComparables = "error_not_enough_comparables"
+	ErrorMongoInsert          = "error_mongo_insert"
+	ErrorMongoUpdate          = "error_mongo_update"
+	ErrorInvalidSpecRange     = "error_invalid_spec_range"
+	ErrorInvalidExtras        = "error_invalid_extras"
+	ErrorBrandNotFound        = "error_brand_not_found"
+	ErrorInvalidStatsRange    = "error_invalid_stats_range"
+	ErrorInvalidByke          = "error_invalid_byke"
+	ErrorDuplicateByke        = "error_duplicate_byke"
+	ErrorVersionRequired      = "error_version_required"
+	ErrorVersionConflict      = "error_version_conflict"
 )
 
 type ErrorInfo struct {
@@ -52,7 +81,7 @@ var ErrorMappingsResponse = map[string]ErrorInfo{
 	ErrorInvalidStringBike: {
 		Success: SuccessStatus,
 		Code:    http.StatusBadRequest,
-		Message: "Byke name can only contain letters",
+		Message: "Byke name can only contain letters, numbers, spaces, hyphens and dots",
 	},
 	ErrorBikesNotFound: {
 		Success: SuccessStatus,
@@ -94,6 +123,116 @@ var ErrorMappingsResponse = map[string]ErrorInfo{
 		Code:    http.StatusBadRequest,
 		Message: "Path Param is not valid, only letters and numbers",
 	},
+	ErrorInvalidPriceRange: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Price range is not valid, price_min and price_max must be positive and price_min lower than price_max",
+	},
+	ErrorInvalidYearRange: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Year range is not valid, year_min and year_max must be positive and year_min lower than year_max",
+	},
+	ErrorInvalidKm: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "km_max must be a positive number",
+	},
+	ErrorInvalidSort: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Sort is not valid, use price_asc, price_desc, newest, km_asc, year_desc, deal or relevance",
+	},
+	ErrorInvalidCursor: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Cursor is not valid or does not match the sort",
+	},
+	ErrorInvalidSearchMode: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Mode is not valid, use substring or text. Sort relevance needs mode text with a name and does not support cursor",
+	},
+	ErrorInvalidLocation: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "City or department not found, use the name or DANE code from /locations",
+	},
+	ErrorInvalidSpecRange: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Spec range is not valid, cc_min, cc_max and hp_min must be positive and cc_min lower than cc_max",
+	},
+	ErrorInvalidExtras: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Extras is not valid, use the tags from /extras separated by commas",
+	},
+	ErrorBrandNotFound: {
+		Success: SuccessStatus,
+		Code:    http.StatusNotFound,
+		Message: "Brand not found",
+	},
+	ErrorInvalidStatsRange: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Date range is not valid, from and to must have the format YYYY-MM-DD, from before to and at most 366 days",
+	},
+	ErrorInvalidByke: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "%s",
+	},
+	ErrorVersionRequired: {
+		Success: SuccessStatus,
+		Code:    http.StatusPreconditionRequired,
+		Message: "The version of the byke is required, send it in the If-Match header or as version",
+	},
+	ErrorVersionConflict: {
+		Success: SuccessStatus,
+		Code:    http.StatusPreconditionFailed,
+		Message: "The byke was modified by another request, read it again and retry with its current version",
+	},
+	ErrorInvalidGeo: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "lat, lng and radius_km must be sent together, lat between -90 and 90, lng between -180 and 180 and radius_km between 1 and 1000",
+	},
+	ErrorInvalidCompare: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "hash must be sent between 2 and 4 times, each one alphanumeric of 12 characters",
+	},
+	ErrorInvalidBatch: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "hashes must have between 1 and 50 items, each one alphanumeric of 12 characters",
+	},
+	ErrorInvalidSavedSearch: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "owner_id is required and can only contain letters, numbers, hyphens and underscores",
+	},
+	ErrorSavedSearchNotFound: {
+		Success: SuccessStatus,
+		Code:    http.StatusNotFound,
+		Message: "Saved search not found",
+	},
+	ErrorInvalidValuation: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "brand and model are required, year and km must be positive numbers",
+	},
+	ErrorNotEnoughComparables: {
+		Success: SuccessStatus,
+		Code:    http.StatusNotFound,
+		Message: "Not enough comparable bikes to estimate a price",
+	},
+	ErrorInvalidDeal: {
+		Success: SuccessStatus,
+		Code:    http.StatusBadRequest,
+		Message: "Deal is not valid, use great, good, fair or high separated by commas",
+	},
 	ErrorUnexpected: {
 		Success: SuccessStatus,
 		Code:    http.StatusInternalServerError,
@@ -0,0 +1,103 @@
+package extras
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+)
+
+// dictionary.json contiene las etiquetas canonicas de equipamiento y los sinonimos con los que aparecen en las publicaciones
+//
+//go:embed dictionary.json
+var dictionaryFile []byte
+
+// Tag es una etiqueta canonica de equipamiento
+type Tag struct {
+	Tag      string   `json:"tag"`
+	Label    string   `json:"label"`
+	Synonyms []string `json:"synonyms"`
+}
+
+type dictionary struct {
+	tags   []Tag
+	byTag  map[string]*Tag
+	lookup []synonym
+}
+
+type synonym struct {
+	words string
+	tag   string
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+var defaultDictionary = loadDictionary()
+
+func loadDictionary() *dictionary {
+	var data struct {
+		Tags []Tag `json:"tags"`
+	}
+
+	if err := json.Unmarshal(dictionaryFile, &data); err != nil {
+		log.Panicf("error loading extras dictionary: %v", err)
+	}
+
+	d := &dictionary{
+		tags:  data.Tags,
+		byTag: map[string]*Tag{},
+	}
+
+	for i := range d.tags {
+		tag := &d.tags[i]
+		d.byTag[tag.Tag] = tag
+		for _, words := range tag.Synonyms {
+			d.lookup = append(d.lookup, synonym{words: " " + normalize(words) + " ", tag: tag.Tag})
+		}
+	}
+
+	return d
+}
+
+// All retorna las etiquetas del diccionario
+func All() []Tag {
+	return defaultDictionary.tags
+}
+
+// IsValid indica si la etiqueta existe en el diccionario
+func IsValid(tag string) bool {
+	_, ok := defaultDictionary.byTag[tag]
+	return ok
+}
+
+// Canonical convierte los extras escritos libremente ("ABS bosch", "maletas laterales", "exploradoras")
+// en las etiquetas canonicas que mencionan, sin repetir y ordenadas. Los sinonimos se buscan como palabras
+// completas, asi "tc" no coincide dentro de "tcs" pero "abs y control de traccion" da dos etiquetas
+func Canonical(raw []string) []string {
+	found := map[string]bool{}
+	for _, extra := range raw {
+		value := " " + normalize(extra) + " "
+		for _, synonym := range defaultDictionary.lookup {
+			if strings.Contains(value, synonym.words) {
+				found[synonym.tag] = true
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(found))
+	for tag := range found {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+
+	return tags
+}
+
+// normalize deja el texto sin tildes, en minusculas y con solo letras y numeros separados por un espacio
+func normalize(value string) string {
+	return strings.TrimSpace(nonAlphanumeric.ReplaceAllString(text.Fold(value), " "))
+}
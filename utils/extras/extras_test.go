@@ -0,0 +1,49 @@
+package extras
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanonical(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  []string
+		want []string
+	}{
+		{"empty", nil, []string{}},
+		{"exact synonym", []string{"ABS"}, []string{"abs"}},
+		{"synonym inside a longer text", []string{"ABS bosch"}, []string{"abs"}},
+		{"accents and case", []string{"Control de Tracción"}, []string{"traction_control"}},
+		{"several tags in one extra", []string{"abs y control de traccion"}, []string{"abs", "traction_control"}},
+		{"punctuation between words", []string{"maletas-laterales!!"}, []string{"side_cases"}},
+		{"repeated tags counted once", []string{"Exploradoras", "luces exploradoras"}, []string{"fog_lights"}},
+		{"sorted tags", []string{"exploradoras", "abs"}, []string{"abs", "fog_lights"}},
+		{"whole words only", []string{"abstracto"}, []string{}},
+		{"short synonym not inside another word", []string{"tcs"}, []string{"traction_control"}},
+		{"unknown extra", []string{"rines de lujo"}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Canonical(tt.raw); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Canonical(%q) = %v, want %v", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	for _, tag := range All() {
+		if !IsValid(tag.Tag) {
+			t.Errorf("IsValid(%q) = false for a dictionary tag", tag.Tag)
+		}
+		if tag.Label == "" || len(tag.Synonyms) == 0 {
+			t.Errorf("tag %q needs a label and at least one synonym", tag.Tag)
+		}
+	}
+
+	if IsValid("not_a_tag") {
+		t.Error(`IsValid("not_a_tag") = true, want false`)
+	}
+}
@@ -0,0 +1,323 @@
+package location
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+)
+
+// catalog.json contiene los departamentos de Colombia y todos sus municipios y areas no municipalizadas
+// de la DIVIPOLA con el codigo DANE
+//
+//go:embed catalog.json
+var catalogFile []byte
+
+type Department struct {
+	Code    string   `json:"code"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type Municipality struct {
+	Code           string   `json:"code"`
+	Name           string   `json:"name"`
+	DepartmentCode string   `json:"department_code"`
+	Aliases        []string `json:"aliases"`
+	// Main marca las capitales y ciudades principales, que se prefieren cuando un nombre se repite
+	Main bool `json:"main"`
+}
+
+// Match es el resultado de normalizar un texto libre contra el catalogo
+type Match struct {
+	Municipality *Municipality
+	Department   *Department
+}
+
+type catalog struct {
+	departments    []Department
+	municipalities []Municipality
+	departmentsBy  map[string]*Department
+	// departmentKeys y municipalityKeys son los nombres normalizados de cada departamento y municipio, en el mismo orden
+	departmentKeys   [][]string
+	municipalityKeys [][]string
+	// nameDepartments son los departamentos que tienen un municipio (o son un departamento) con cada nombre
+	nameDepartments map[string]map[string]bool
+}
+
+var nonAlphanumeric = regexp.MustCompile(`[^a-z0-9]+`)
+
+// needsDepartment son nombres de municipio que en una ubicacion casi siempre son otra cosa (el pais),
+// solo se toman como municipio si tambien se menciona su departamento
+var needsDepartment = map[string]bool{"colombia": true}
+
+var defaultCatalog = loadCatalog()
+
+func loadCatalog() *catalog {
+	var data struct {
+		Departments    []Department   `json:"departments"`
+		Municipalities []Municipality `json:"municipalities"`
+	}
+
+	if err := json.Unmarshal(catalogFile, &data); err != nil {
+		log.Panicf("error loading location catalog: %v", err)
+	}
+
+	c := &catalog{
+		departments:     data.Departments,
+		municipalities:  data.Municipalities,
+		departmentsBy:   map[string]*Department{},
+		nameDepartments: map[string]map[string]bool{},
+	}
+
+	for i := range c.departments {
+		c.departmentsBy[c.departments[i].Code] = &c.departments[i]
+		c.departmentKeys = append(c.departmentKeys, c.addNames(c.departments[i].Names(), c.departments[i].Code))
+	}
+
+	for _, municipality := range c.municipalities {
+		c.municipalityKeys = append(c.municipalityKeys, c.addNames(municipality.Names(), municipality.DepartmentCode))
+	}
+
+	return c
+}
+
+// Municipalities retorna los municipios del catalogo
+func Municipalities() []Municipality {
+	return defaultCatalog.municipalities
+}
+
+// Departments retorna los departamentos del catalogo
+func Departments() []Department {
+	return defaultCatalog.departments
+}
+
+// DepartmentByCode retorna el departamento con el codigo DANE indicado
+func DepartmentByCode(code string) *Department {
+	return defaultCatalog.departmentsBy[code]
+}
+
+// addNames registra los nombres en nameDepartments y los retorna normalizados
+func (c *catalog) addNames(names []string, departmentCode string) []string {
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key := normalize(name)
+		if c.nameDepartments[key] == nil {
+			c.nameDepartments[key] = map[string]bool{}
+		}
+		c.nameDepartments[key][departmentCode] = true
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// FindMunicipalities busca un municipio por codigo DANE o por nombre (sin importar tildes).
+// Un nombre que existe en varios departamentos ("La Unión") retorna todos sus municipios, salvo que el texto
+// mencione el departamento ("La Unión, Nariño"), en cuyo caso se resuelve como Normalize
+func FindMunicipalities(value string) []Municipality {
+	key := normalize(value)
+	if key == "" {
+		return nil
+	}
+
+	found := []Municipality{}
+	for i, municipality := range defaultCatalog.municipalities {
+		if municipality.Code == key {
+			return []Municipality{municipality}
+		}
+		if containsKey(defaultCatalog.municipalityKeys[i], key) {
+			found = append(found, municipality)
+		}
+	}
+
+	if len(found) > 0 {
+		return found
+	}
+
+	if match := Normalize(value); match.Municipality != nil {
+		return []Municipality{*match.Municipality}
+	}
+
+	return nil
+}
+
+// FindDepartment busca un departamento por codigo DANE o por nombre (sin importar tildes)
+func FindDepartment(value string) *Department {
+	key := normalize(value)
+	for i := range defaultCatalog.departments {
+		department := &defaultCatalog.departments[i]
+		if department.Code == key || containsKey(defaultCatalog.departmentKeys[i], key) {
+			return department
+		}
+	}
+	return nil
+}
+
+// DepartmentNames retorna los nombres del departamento y de sus municipios que no se repiten en otro departamento,
+// para encontrar las ubicaciones del departamento sin confundir "Armenia" (Antioquia) con la capital del Quindío
+func DepartmentNames(departmentCode string) []string {
+	department := DepartmentByCode(departmentCode)
+	if department == nil {
+		return nil
+	}
+
+	names := department.Names()
+	for _, municipality := range MunicipalitiesOf(departmentCode) {
+		for _, name := range municipality.Names() {
+			if len(defaultCatalog.nameDepartments[normalize(name)]) == 1 {
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// MunicipalitiesOf retorna los municipios de un departamento
+func MunicipalitiesOf(departmentCode string) []Municipality {
+	municipalities := []Municipality{}
+	for _, municipality := range defaultCatalog.municipalities {
+		if municipality.DepartmentCode == departmentCode {
+			municipalities = append(municipalities, municipality)
+		}
+	}
+	return municipalities
+}
+
+// Normalize relaciona un texto libre como "Medellin - Antioquia" o "bogota d.c" con el catalogo.
+// Si el texto menciona varios municipios se prefiere el que pertenece al departamento mencionado,
+// despues el de nombre mas largo y despues el principal (ver resolveTie). Un municipio de otro departamento
+// no se toma si su nombre es el del departamento mencionado ("Caldas" es el departamento, no el municipio de Antioquia)
+func Normalize(value string) Match {
+	normalized := " " + normalize(value) + " "
+	if strings.TrimSpace(normalized) == "" {
+		return Match{}
+	}
+
+	var department *Department
+	departmentKey := ""
+	for i := range defaultCatalog.departments {
+		if key := longestMention(normalized, defaultCatalog.departmentKeys[i]); len(key) > len(departmentKey) {
+			department = &defaultCatalog.departments[i]
+			departmentKey = key
+		}
+	}
+
+	// Candidates tied in the best position, ranked by department first and then by name length
+	best := []*Municipality{}
+	bestInDepartment, bestLength := false, 0
+	for i := range defaultCatalog.municipalities {
+		candidate := &defaultCatalog.municipalities[i]
+		key := longestMention(normalized, defaultCatalog.municipalityKeys[i])
+		if key == "" {
+			continue
+		}
+
+		inDepartment := department != nil && candidate.DepartmentCode == department.Code
+		if !inDepartment && (key == departmentKey || needsDepartment[key]) {
+			continue
+		}
+
+		length := len(key)
+		switch {
+		case len(best) == 0 || (inDepartment && !bestInDepartment) || (inDepartment == bestInDepartment && length > bestLength):
+			best = []*Municipality{candidate}
+			bestInDepartment, bestLength = inDepartment, length
+		case inDepartment == bestInDepartment && length == bestLength:
+			best = append(best, candidate)
+		}
+	}
+
+	municipality := resolveTie(best)
+	if municipality != nil {
+		department = DepartmentByCode(municipality.DepartmentCode)
+	}
+
+	return Match{Municipality: municipality, Department: department}
+}
+
+// resolveTie elige entre municipios con el mismo nombre y sin departamento que los distinga el unico principal
+// ("Armenia" es la capital del Quindío); si no hay uno solo el texto es ambiguo y no se elige ninguno
+func resolveTie(candidates []*Municipality) *Municipality {
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+
+	var main *Municipality
+	for _, candidate := range candidates {
+		if !candidate.Main {
+			continue
+		}
+		if main != nil {
+			return nil
+		}
+		main = candidate
+	}
+	return main
+}
+
+// Pattern arma una expresion regular para $regex que encuentra cualquiera de los nombres
+// como palabra completa y sin importar tildes
+func Pattern(names []string) string {
+	alternatives := make([]string, 0, len(names))
+	seen := map[string]bool{}
+	for _, name := range names {
+		key := normalize(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		// Any punctuation or spaces between words, so "bogota d c" finds "Bogotá D.C"
+		words := strings.Fields(key)
+		for i, word := range words {
+			words[i] = text.AccentInsensitivePattern(word)
+		}
+		alternatives = append(alternatives, strings.Join(words, `[^a-z0-9]+`))
+	}
+
+	// Longer names first so "santa rosa de cabal" wins over "santa rosa"
+	sort.Slice(alternatives, func(i, j int) bool {
+		return len(alternatives[i]) > len(alternatives[j])
+	})
+
+	return `(^|[^a-z0-9])(` + strings.Join(alternatives, "|") + `)([^a-z0-9]|$)`
+}
+
+// Names retorna el nombre y los alias del municipio
+func (m Municipality) Names() []string {
+	return append([]string{m.Name}, m.Aliases...)
+}
+
+// Names retorna el nombre y los alias del departamento
+func (d Department) Names() []string {
+	return append([]string{d.Name}, d.Aliases...)
+}
+
+// longestMention retorna el nombre normalizado mas largo que aparece como palabra completa en el texto
+func longestMention(normalized string, keys []string) string {
+	longest := ""
+	for _, key := range keys {
+		if key != "" && strings.Contains(normalized, " "+key+" ") && len(key) > len(longest) {
+			longest = key
+		}
+	}
+	return longest
+}
+
+func containsKey(keys []string, key string) bool {
+	for _, candidate := range keys {
+		if candidate == key {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize deja el texto sin tildes, en minusculas y con la puntuacion reemplazada por espacios
+func normalize(value string) string {
+	return strings.TrimSpace(nonAlphanumeric.ReplaceAllString(text.Fold(value), " "))
+}
@@ -0,0 +1,66 @@
+package location
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name             string
+		value            string
+		wantMunicipality string
+		wantDepartment   string
+	}{
+		{"accented name", "Medellín", "05001", "05"},
+		{"with department", "Medellin - Antioquia", "05001", "05"},
+		{"alias with punctuation", "bogota d.c", "11001", "11"},
+		{"longer name wins", "Santa Rosa de Cabal", "66682", "66"},
+		{"country is ignored", "Envigado, Colombia", "05266", "05"},
+		{"repeated name resolved by department", "La Unión, Nariño", "52399", "52"},
+		{"repeated name resolved by department alias", "La union valle", "76400", "76"},
+		{"repeated name without department is ambiguous", "La Unión", "", ""},
+		{"repeated name prefers the main municipality", "Armenia", "63001", "63"},
+		{"main municipality loses to the department", "Armenia, Antioquia", "05059", "05"},
+		{"department name is not the municipality", "Caldas", "", "17"},
+		{"municipality named like a department", "Caldas, Antioquia", "05129", "05"},
+		{"municipality named like the country needs its department", "Colombia", "", ""},
+		{"municipality named like the country with department", "Colombia, Huila", "41206", "41"},
+		{"unknown", "xyz", "", ""},
+		{"empty", "  ", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := Normalize(tt.value)
+
+			gotMunicipality, gotDepartment := "", ""
+			if match.Municipality != nil {
+				gotMunicipality = match.Municipality.Code
+			}
+			if match.Department != nil {
+				gotDepartment = match.Department.Code
+			}
+
+			if gotMunicipality != tt.wantMunicipality || gotDepartment != tt.wantDepartment {
+				t.Errorf("Normalize(%q) = (%q, %q), want (%q, %q)", tt.value, gotMunicipality, gotDepartment, tt.wantMunicipality, tt.wantDepartment)
+			}
+		})
+	}
+}
+
+func TestFindMunicipalities(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{"05001", 1},
+		{"medellin", 1},
+		{"La Unión", 4},
+		{"La Unión, Nariño", 1},
+		{"Narnia", 0},
+	}
+
+	for _, tt := range tests {
+		if got := FindMunicipalities(tt.value); len(got) != tt.want {
+			t.Errorf("FindMunicipalities(%q) returned %d municipalities, want %d", tt.value, len(got), tt.want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package location
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log"
+	"math"
+)
+
+// gazetteer.json contiene las coordenadas aproximadas del centro de cada municipio del catalogo
+//
+//go:embed gazetteer.json
+var gazetteerFile []byte
+
+const earthRadiusKm = 6378.1
+
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+var gazetteer = loadGazetteer()
+
+func loadGazetteer() map[string]Coordinates {
+	coordinates := map[string]Coordinates{}
+	if err := json.Unmarshal(gazetteerFile, &coordinates); err != nil {
+		log.Panicf("error loading location gazetteer: %v", err)
+	}
+	return coordinates
+}
+
+// CoordinatesOf retorna las coordenadas del municipio con el codigo DANE indicado
+func CoordinatesOf(code string) (Coordinates, bool) {
+	coordinates, ok := gazetteer[code]
+	return coordinates, ok
+}
+
+// Locate normaliza un texto libre de ubicacion y retorna las coordenadas de su municipio
+func Locate(value string) (Coordinates, bool) {
+	match := Normalize(value)
+	if match.Municipality == nil {
+		return Coordinates{}, false
+	}
+	return CoordinatesOf(match.Municipality.Code)
+}
+
+// RadiansFromKm convierte una distancia en km a radianes, como lo espera $centerSphere
+func RadiansFromKm(km float64) float64 {
+	return km / earthRadiusKm
+}
+
+// DistanceKm calcula la distancia en km entre dos puntos con la formula de haversine
+func DistanceKm(from, to Coordinates) float64 {
+	toRadians := func(degrees float64) float64 {
+		return degrees * math.Pi / 180
+	}
+
+	deltaLat := toRadians(to.Lat - from.Lat)
+	deltaLng := toRadians(to.Lng - from.Lng)
+
+	a := math.Sin(deltaLat/2)*math.Sin(deltaLat/2) +
+		math.Cos(toRadians(from.Lat))*math.Cos(toRadians(to.Lat))*math.Sin(deltaLng/2)*math.Sin(deltaLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,64 @@
+package location
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGazetteerCoversCatalog(t *testing.T) {
+	for _, municipality := range Municipalities() {
+		coordinates, ok := CoordinatesOf(municipality.Code)
+		if !ok {
+			t.Errorf("municipality %s %s has no coordinates", municipality.Code, municipality.Name)
+			continue
+		}
+
+		// Bounding box of Colombia, San Andrés included
+		if coordinates.Lat < -4.3 || coordinates.Lat > 13.6 || coordinates.Lng < -82 || coordinates.Lng > -66.8 {
+			t.Errorf("municipality %s %s has coordinates %+v outside Colombia", municipality.Code, municipality.Name, coordinates)
+		}
+	}
+
+	if len(gazetteer) != len(Municipalities()) {
+		t.Errorf("gazetteer has %d coordinates, want one for each of the %d municipalities", len(gazetteer), len(Municipalities()))
+	}
+}
+
+func TestLocate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+		ok    bool
+	}{
+		{"Medellín, Antioquia", "05001", true},
+		{"La Unión, Nariño", "52399", true},
+		{"Puerto Carreño", "99001", true},
+		{"La Unión", "", false},
+		{"Narnia", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Locate(tt.value)
+		if ok != tt.ok {
+			t.Errorf("Locate(%q) ok = %v, want %v", tt.value, ok, tt.ok)
+			continue
+		}
+		if want, _ := CoordinatesOf(tt.want); ok && got != want {
+			t.Errorf("Locate(%q) = %+v, want the coordinates of %s %+v", tt.value, got, tt.want, want)
+		}
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	medellin, _ := CoordinatesOf("05001")
+	bogota, _ := CoordinatesOf("11001")
+
+	if got := DistanceKm(medellin, medellin); got != 0 {
+		t.Errorf("DistanceKm to itself = %v, want 0", got)
+	}
+
+	// Medellín and Bogotá are about 240 km apart in a straight line
+	if got := DistanceKm(medellin, bogota); math.Abs(got-240) > 15 {
+		t.Errorf("DistanceKm(Medellín, Bogotá) = %.1f, want about 240", got)
+	}
+}
@@ -0,0 +1,135 @@
+package paperwork
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+)
+
+// Colombia es la zona horaria en la que se interpretan las fechas de vencimiento (UTC-5, sin horario de verano)
+var Colombia = time.FixedZone("COT", -5*60*60)
+
+var (
+	// fullDatePattern encuentra fechas numericas como "2025-03-15", "15/03/2025", "15.03.25" o "2025-03-15T00:00:00Z"
+	fullDatePattern = regexp.MustCompile(`\b(\d{1,4})[/\-. ](\d{1,2})[/\-. ](\d{2,4})(?:\b|t)`)
+	// monthYearPattern encuentra mes y año numericos como "03/2025", "2025-03" o "03/26"
+	monthYearPattern = regexp.MustCompile(`\b(\d{1,4})[/\-. ](\d{1,4})\b`)
+	// namedMonthPattern encuentra fechas con el nombre del mes como "15 de marzo de 2025", "marzo 2025" o "mar/25"
+	namedMonthPattern = regexp.MustCompile(`(?:\b(\d{1,2})\s*(?:de\s+|[/\-. ])?)?\b(ene|feb|mar|abr|may|jun|jul|ago|sep|set|oct|nov|dic)[a-z]*\.?\s*(?:del?\s+|[/\-. ])?\s*(\d{4}|\d{2})\b`)
+	// unixPattern reconoce timestamps en segundos
+	unixPattern = regexp.MustCompile(`^\d{10}$`)
+)
+
+var months = map[string]time.Month{
+	"ene": time.January,
+	"feb": time.February,
+	"mar": time.March,
+	"abr": time.April,
+	"may": time.May,
+	"jun": time.June,
+	"jul": time.July,
+	"ago": time.August,
+	"sep": time.September,
+	"set": time.September,
+	"oct": time.October,
+	"nov": time.November,
+	"dic": time.December,
+}
+
+// ParseExpiry interpreta la fecha de vencimiento de un documento como la guardan las publicaciones:
+// "2025-03-15", "15/03/2025", "03/2025", "03/26", "15 de marzo de 2025", "marzo 2025" o un timestamp en segundos.
+// Las fechas numericas se leen dia/mes/año salvo que empiecen por el año, y cuando no traen dia
+// se toma el ultimo dia del mes. Retorna el inicio de ese dia en hora de Colombia
+func ParseExpiry(value string) (time.Time, bool) {
+	folded := text.Fold(value)
+	if folded == "" {
+		return time.Time{}, false
+	}
+
+	if unixPattern.MatchString(folded) {
+		seconds, _ := strconv.ParseInt(folded, 10, 64)
+		return StartOfDay(time.Unix(seconds, 0)), true
+	}
+
+	if match := fullDatePattern.FindStringSubmatch(folded); match != nil {
+		first, second, third := atoi(match[1]), atoi(match[2]), atoi(match[3])
+		if len(match[1]) == 4 {
+			return date(first, second, third)
+		}
+		return date(fullYear(third, match[3]), second, first)
+	}
+
+	if match := namedMonthPattern.FindStringSubmatch(folded); match != nil {
+		year := fullYear(atoi(match[3]), match[3])
+		if match[1] == "" {
+			return endOfMonth(year, int(months[match[2]]))
+		}
+		return date(year, int(months[match[2]]), atoi(match[1]))
+	}
+
+	if match := monthYearPattern.FindStringSubmatch(folded); match != nil {
+		if len(match[1]) == 4 {
+			return endOfMonth(atoi(match[1]), atoi(match[2]))
+		}
+		if len(match[2]) == 4 || (len(match[1]) <= 2 && len(match[2]) == 2) {
+			return endOfMonth(fullYear(atoi(match[2]), match[2]), atoi(match[1]))
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// IsExpiredText indica si el texto dice que el documento esta vencido aunque no tenga fecha
+func IsExpiredText(value string) bool {
+	return strings.Contains(text.Fold(value), "vencid")
+}
+
+// StartOfDay retorna la medianoche en hora de Colombia del dia de t
+func StartOfDay(t time.Time) time.Time {
+	year, month, day := t.In(Colombia).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, Colombia)
+}
+
+// DaysUntil retorna los dias que faltan desde now hasta expiry, negativo si ya vencio
+func DaysUntil(expiry, now time.Time) int {
+	return int(StartOfDay(expiry).Sub(StartOfDay(now)).Hours() / 24)
+}
+
+// date arma la fecha validando que exista, asi "31/02/2025" no se convierte en marzo
+func date(year, month, day int) (time.Time, bool) {
+	if month < 1 || month > 12 || day < 1 {
+		return time.Time{}, false
+	}
+
+	parsed := time.Date(year, time.Month(month), day, 0, 0, 0, 0, Colombia)
+	if parsed.Day() != day {
+		return time.Time{}, false
+	}
+
+	return parsed, true
+}
+
+func endOfMonth(year, month int) (time.Time, bool) {
+	if month < 1 || month > 12 {
+		return time.Time{}, false
+	}
+
+	// Day 0 of the next month is the last day of this one
+	return time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, Colombia), true
+}
+
+// fullYear completa los años de dos digitos, "25" es 2025
+func fullYear(year int, raw string) int {
+	if len(raw) == 2 {
+		return 2000 + year
+	}
+	return year
+}
+
+func atoi(value string) int {
+	number, _ := strconv.Atoi(value)
+	return number
+}
@@ -0,0 +1,111 @@
+package paperwork
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseExpiry(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"2025-03-15", "2025-03-15"},
+		{"2025-03-15T00:00:00Z", "2025-03-15"},
+		{"15/03/2025", "2025-03-15"},
+		{"15-03-2025", "2025-03-15"},
+		{"15.03.25", "2025-03-15"},
+		{"Vence el 5/3/2025", "2025-03-05"},
+		{"03/2025", "2025-03-31"},
+		{"2025-03", "2025-03-31"},
+		{"03/26", "2026-03-31"},
+		{"3/26", "2026-03-31"},
+		{"02/28", "2028-02-29"},
+		{"15 de marzo de 2025", "2025-03-15"},
+		{"15 de Marzo del 2025", "2025-03-15"},
+		{"marzo 2025", "2025-03-31"},
+		{"Septiembre de 2025", "2025-09-30"},
+		{"mar/25", "2025-03-31"},
+		{"1741996800", "2025-03-14"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, ok := ParseExpiry(tt.value)
+			if !ok {
+				t.Fatalf("ParseExpiry(%q) ok = false, want %s", tt.value, tt.want)
+			}
+			if got.Location() != Colombia || got.Hour() != 0 {
+				t.Errorf("ParseExpiry(%q) = %v, want the start of the day in Colombia", tt.value, got)
+			}
+			if date := got.Format(time.DateOnly); date != tt.want {
+				t.Errorf("ParseExpiry(%q) = %s, want %s", tt.value, date, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseExpiryInvalid(t *testing.T) {
+	tests := []string{
+		"",
+		"al dia",
+		"vencido",
+		"31/02/2025",
+		"29/02/2025",
+		"32/01/2025",
+		"15/13/2025",
+		"2025-02-30",
+		"13/2025",
+		"00/26",
+		"13/26",
+		"2025-13",
+	}
+
+	for _, value := range tests {
+		t.Run(value, func(t *testing.T) {
+			if got, ok := ParseExpiry(value); ok {
+				t.Errorf("ParseExpiry(%q) = %v, want ok = false", value, got)
+			}
+		})
+	}
+}
+
+func TestDaysUntil(t *testing.T) {
+	expiry := time.Date(2025, time.March, 15, 0, 0, 0, 0, Colombia)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want int
+	}{
+		{"same day", time.Date(2025, time.March, 15, 23, 0, 0, 0, Colombia), 0},
+		{"day before", time.Date(2025, time.March, 14, 8, 0, 0, 0, Colombia), 1},
+		{"expired", time.Date(2025, time.March, 20, 8, 0, 0, 0, Colombia), -5},
+		// 02:00 UTC is still the previous day in Colombia
+		{"utc after midnight", time.Date(2025, time.March, 15, 2, 0, 0, 0, time.UTC), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DaysUntil(expiry, tt.now); got != tt.want {
+				t.Errorf("DaysUntil() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsExpiredText(t *testing.T) {
+	tests := map[string]bool{
+		"Vencido":      true,
+		"SOAT VENCIDA": true,
+		"al dia":       false,
+		"15/03/2025":   false,
+		"":             false,
+	}
+
+	for value, want := range tests {
+		if got := IsExpiredText(value); got != want {
+			t.Errorf("IsExpiredText(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
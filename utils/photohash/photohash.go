@@ -0,0 +1,93 @@
+package photohash
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	_ "image/png"
+	"math/bits"
+	"strconv"
+)
+
+// Tamaño de la grilla de grises: cada fila compara 9 celdas vecinas y da 8 bits
+const (
+	gridWidth  = 9
+	gridHeight = 8
+)
+
+// DHash calcula el hash de diferencias de 64 bits de una imagen JPEG o PNG: la reduce a una grilla
+// de 9x8 grises y marca si cada celda es mas clara que la de su izquierda. Dos copias de la misma foto
+// (recomprimida, redimensionada o con otro nombre) quedan a pocos bits de distancia
+func DHash(content []byte) (uint64, error) {
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return 0, fmt.Errorf("error decoding image: %w", err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() < gridWidth || bounds.Dy() < gridHeight {
+		return 0, fmt.Errorf("image too small: %dx%d", bounds.Dx(), bounds.Dy())
+	}
+
+	var grid [gridHeight][gridWidth]float64
+	for row := range gridHeight {
+		for col := range gridWidth {
+			grid[row][col] = cellMean(img, bounds, row, col)
+		}
+	}
+
+	var hash uint64
+	for row := range gridHeight {
+		for col := range gridWidth - 1 {
+			hash <<= 1
+			if grid[row][col] < grid[row][col+1] {
+				hash |= 1
+			}
+		}
+	}
+
+	return hash, nil
+}
+
+// cellMean promedia el gris de los pixeles de una celda de la grilla
+func cellMean(img image.Image, bounds image.Rectangle, row, col int) float64 {
+	minX := bounds.Min.X + col*bounds.Dx()/gridWidth
+	maxX := bounds.Min.X + (col+1)*bounds.Dx()/gridWidth
+	minY := bounds.Min.Y + row*bounds.Dy()/gridHeight
+	maxY := bounds.Min.Y + (row+1)*bounds.Dy()/gridHeight
+
+	total := 0
+	for y := minY; y < maxY; y++ {
+		for x := minX; x < maxX; x++ {
+			total += int(gray(img, x, y))
+		}
+	}
+
+	return float64(total) / float64((maxX-minX)*(maxY-minY))
+}
+
+// gray lee la luminancia del pixel, directo del plano Y en los JPEG
+func gray(img image.Image, x, y int) uint8 {
+	if ycbcr, ok := img.(*image.YCbCr); ok {
+		return ycbcr.Y[ycbcr.YOffset(x, y)]
+	}
+	return color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+}
+
+// Distance cuenta los bits distintos entre dos hashes
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Format convierte el hash a hexadecimal para guardarlo
+func Format(hash uint64) string {
+	return strconv.FormatUint(hash, 16)
+}
+
+// Parse lee un hash guardado con Format
+func Parse(value string) (uint64, bool) {
+	hash, err := strconv.ParseUint(value, 16, 64)
+	return hash, err == nil
+}
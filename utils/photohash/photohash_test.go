@@ -0,0 +1,109 @@
+package photohash
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+// testImage dibuja un degradado con un rectangulo claro, escalado al tamaño pedido
+func testImage(width, height int, mirrored bool) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			fx := float64(x) / float64(width)
+			if mirrored {
+				fx = 1 - fx
+			}
+			fy := float64(y) / float64(height)
+			value := uint8(200 * fx * fy)
+			if fx > 0.2 && fx < 0.45 && fy > 0.3 && fy < 0.7 {
+				value = 250
+			}
+			img.Set(x, y, color.RGBA{R: value, G: value / 2, B: 255 - value, A: 255})
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image, quality int) []byte {
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, img, &jpeg.Options{Quality: quality}); err != nil {
+		t.Fatalf("jpeg.Encode() error = %v", err)
+	}
+	return buffer.Bytes()
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buffer bytes.Buffer
+	if err := png.Encode(&buffer, img); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	return buffer.Bytes()
+}
+
+func mustHash(t *testing.T, content []byte) uint64 {
+	hash, err := DHash(content)
+	if err != nil {
+		t.Fatalf("DHash() error = %v", err)
+	}
+	return hash
+}
+
+func TestDHash(t *testing.T) {
+	original := mustHash(t, encodeJPEG(t, testImage(640, 480, false), 90))
+
+	tests := []struct {
+		name    string
+		content []byte
+		similar bool
+	}{
+		{"recompressed", encodeJPEG(t, testImage(640, 480, false), 40), true},
+		{"resized", encodeJPEG(t, testImage(320, 240, false), 75), true},
+		{"png", encodePNG(t, testImage(640, 480, false)), true},
+		{"other photo", encodeJPEG(t, testImage(640, 480, true), 90), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			distance := Distance(original, mustHash(t, tt.content))
+			if similar := distance <= 10; similar != tt.similar {
+				t.Errorf("Distance() = %d, similar = %v, want %v", distance, similar, tt.similar)
+			}
+		})
+	}
+}
+
+func TestDHashInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content []byte
+	}{
+		{"empty", nil},
+		{"not an image", []byte("not an image")},
+		{"too small", encodePNG(t, testImage(4, 4, false))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := DHash(tt.content); err == nil {
+				t.Errorf("DHash(%s) error = nil, want error", tt.name)
+			}
+		})
+	}
+}
+
+func TestFormatParse(t *testing.T) {
+	for _, hash := range []uint64{0, 1, 0xf0f0f0f0f0f0f0f0, ^uint64(0)} {
+		parsed, ok := Parse(Format(hash))
+		if !ok || parsed != hash {
+			t.Errorf("Parse(Format(%x)) = %x, %v", hash, parsed, ok)
+		}
+	}
+	if _, ok := Parse(""); ok {
+		t.Errorf("Parse(\"\") ok = true, want false")
+	}
+}
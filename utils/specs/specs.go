@@ -0,0 +1,141 @@
+package specs
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Bikes2Road/bikes-compass/utils/text"
+)
+
+// Factores de conversion a la unidad base de cada especificacion
+const (
+	kwToHp      = 1.34102
+	cvToHp      = 0.98632
+	kgfmToNm    = 9.80665
+	lbftToNm    = 1.35582
+	lbToKg      = 0.453592
+	litersToCc  = 1000
+	thousandLen = 3
+)
+
+// quantityPattern encuentra un numero (con separador de miles o decimales) y la unidad que le sigue
+var quantityPattern = regexp.MustCompile(`(\d+(?:[.,]\d+)*)\s*([a-z][a-z0-9·.\-/]*)?`)
+
+// unitCleaner quita la puntuacion de la unidad, asi "kgf·m", "kg-m" y "kgf.m" quedan como "kgfm"/"kgm"
+var unitCleaner = strings.NewReplacer("·", "", ".", "", "-", "", "/", "")
+
+// conversions relaciona cada unidad reconocida con el factor para llevarla a la unidad base
+type conversions map[string]float64
+
+var (
+	cylinderUnits = conversions{"cc": 1, "cm3": 1, "cm": 1, "l": litersToCc, "lt": litersToCc, "litros": litersToCc}
+	powerUnits    = conversions{"hp": 1, "bhp": 1, "cv": cvToHp, "ps": cvToHp, "kw": kwToHp}
+	torqueUnits   = conversions{"nm": 1, "kgfm": kgfmToNm, "kgm": kgfmToNm, "mkg": kgfmToNm, "lbft": lbftToNm, "ftlb": lbftToNm}
+	weightUnits   = conversions{"kg": 1, "kgs": 1, "kilos": 1, "lb": lbToKg, "lbs": lbToKg, "libras": lbToKg}
+)
+
+// decimalUnits son las unidades en las que un solo separador siempre es decimal: "1.200 L" son 1,2 litros
+var decimalUnits = map[string]bool{"l": true, "lt": true, "litros": true}
+
+// Values son las especificaciones de una moto en su unidad base, nil cuando el texto no se pudo interpretar
+type Values struct {
+	CylinderCc   *float64
+	HorsePowerHp *float64
+	TorqueNm     *float64
+	WeightKg     *float64
+}
+
+// Parse convierte los textos de cilindraje, potencia, torque y peso a cc, hp, Nm y kg redondeados a un decimal
+func Parse(cylinder, horsePower, torque, weight string) Values {
+	return Values{
+		CylinderCc:   rounded(Cylinder(cylinder)),
+		HorsePowerHp: rounded(HorsePower(horsePower)),
+		TorqueNm:     rounded(Torque(torque)),
+		WeightKg:     rounded(Weight(weight)),
+	}
+}
+
+func rounded(value float64, ok bool) *float64 {
+	if !ok || value <= 0 {
+		return nil
+	}
+	value = math.Round(value*10) / 10
+	return &value
+}
+
+// Cylinder convierte un cilindraje como "321 cc", "1.200cc" o "0,65 L" a centimetros cubicos
+func Cylinder(value string) (float64, bool) {
+	return parse(value, cylinderUnits)
+}
+
+// HorsePower convierte una potencia como "42 hp", "41,4 CV" o "31 kW @ 10.750 rpm" a hp
+func HorsePower(value string) (float64, bool) {
+	return parse(value, powerUnits)
+}
+
+// Torque convierte un torque como "29,6 Nm" o "3,0 kgf·m" a Nm
+func Torque(value string) (float64, bool) {
+	return parse(value, torqueUnits)
+}
+
+// Weight convierte un peso como "168 kg" o "370 lb" a kg
+func Weight(value string) (float64, bool) {
+	return parse(value, weightUnits)
+}
+
+// parse toma la primera cantidad con una unidad conocida y la convierte a la unidad base.
+// Si ninguna cantidad tiene unidad se asume que el primer numero ya esta en la unidad base
+func parse(value string, units conversions) (float64, bool) {
+	matches := quantityPattern.FindAllStringSubmatch(text.Fold(value), -1)
+	if len(matches) == 0 {
+		return 0, false
+	}
+
+	for _, match := range matches {
+		unit := unitCleaner.Replace(match[2])
+		factor, ok := units[unit]
+		if !ok {
+			continue
+		}
+		if number, ok := parseNumber(match[1], decimalUnits[unit]); ok {
+			return number * factor, true
+		}
+	}
+
+	if matches[0][2] != "" {
+		return 0, false
+	}
+
+	return parseNumber(matches[0][1], false)
+}
+
+// parseNumber interpreta "1.200" y "1,200" como miles y "13,5", "13.5" o "0.650" como decimales.
+// Con decimal un solo separador siempre es decimal, sin importar cuantos digitos le sigan
+func parseNumber(value string, decimal bool) (float64, bool) {
+	separators := strings.FieldsFunc(value, func(r rune) bool { return r == '.' || r == ',' })
+
+	thousands := len(separators) > 1 && !(decimal && len(separators) == 2)
+	for _, group := range separators[1:] {
+		if len(group) != thousandLen {
+			thousands = false
+		}
+	}
+
+	// "1.200.000" or "1,200" are thousands, a single "1.20", "13,5" or "0.650" is a decimal
+	if thousands && len(separators[0]) <= thousandLen && separators[0][0] != '0' {
+		value = strings.Join(separators, "")
+	} else if len(separators) == 2 {
+		value = separators[0] + "." + separators[1]
+	} else {
+		value = strings.Join(separators, "")
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return number, true
+}
@@ -0,0 +1,71 @@
+package specs
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		value   string
+		decimal bool
+		want    float64
+	}{
+		{"321", false, 321},
+		{"1.200", false, 1200},
+		{"1,200", false, 1200},
+		{"1.200.000", false, 1200000},
+		{"13,5", false, 13.5},
+		{"13.5", false, 13.5},
+		{"1.20", false, 1.2},
+		{"0.650", false, 0.65},
+		{"0,650", false, 0.65},
+		{"1234.567", false, 1234.567},
+		{"1.200", true, 1.2},
+		{"1.200.000", true, 1200000},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseNumber(tt.value, tt.decimal)
+		if !ok || got != tt.want {
+			t.Errorf("parseNumber(%q, %v) = %v, %v, want %v", tt.value, tt.decimal, got, ok, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func(string) (float64, bool)
+		value string
+		want  float64
+		ok    bool
+	}{
+		{"cylinder cc", Cylinder, "321 cc", 321, true},
+		{"cylinder thousands", Cylinder, "1.200cc", 1200, true},
+		{"cylinder liters decimal comma", Cylinder, "0,65 L", 650, true},
+		{"cylinder liters leading zero", Cylinder, "0.650 L", 650, true},
+		{"cylinder liters three decimals", Cylinder, "1.200 L", 1200, true},
+		{"cylinder liters", Cylinder, "1.2 L", 1200, true},
+		{"cylinder without unit", Cylinder, "150", 150, true},
+		{"cylinder unknown unit", Cylinder, "150 pulgadas", 0, false},
+		{"cylinder empty", Cylinder, "", 0, false},
+		{"power hp", HorsePower, "42 hp", 42, true},
+		{"power cv", HorsePower, "41,4 CV", 41.4 * cvToHp, true},
+		{"power kw with rpm", HorsePower, "31 kW @ 10.750 rpm", 31 * kwToHp, true},
+		{"torque nm", Torque, "29,6 Nm", 29.6, true},
+		{"torque kgfm", Torque, "3,0 kgf·m", 3 * kgfmToNm, true},
+		{"torque kg-m", Torque, "3,0 kg-m", 3 * kgfmToNm, true},
+		{"weight kg", Weight, "168 kg", 168, true},
+		{"weight lb", Weight, "370 lb", 370 * lbToKg, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.parse(tt.value)
+			if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("parse(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var ErrSingular = errors.New("regression has no unique solution")
+
+// Quantile calcula el cuantil q (entre 0 y 1) de los valores con interpolacion lineal.
+// Los valores deben estar ordenados de menor a mayor
+func Quantile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	position := q * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	if lower == upper {
+		return sorted[lower]
+	}
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
+
+// Summary resume la distribucion de un conjunto de valores
+type Summary struct {
+	Count  int
+	Mean   float64
+	Median float64
+	Q1     float64
+	Q3     float64
+}
+
+// IQR retorna el rango intercuartil
+func (s Summary) IQR() float64 {
+	return s.Q3 - s.Q1
+}
+
+// Summarize calcula la media, mediana y cuartiles de los valores sin modificar el slice recibido
+func Summarize(values []float64) Summary {
+	if len(values) == 0 {
+		return Summary{}
+	}
+
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+
+	total := 0.0
+	for _, value := range sorted {
+		total += value
+	}
+
+	return Summary{
+		Count:  len(sorted),
+		Mean:   total / float64(len(sorted)),
+		Median: Quantile(sorted, 0.5),
+		Q1:     Quantile(sorted, 0.25),
+		Q3:     Quantile(sorted, 0.75),
+	}
+}
+
+// LinearRegression ajusta y = b0 + b1*x1 + ... + bn*xn por minimos cuadrados y retorna [b0, b1, ..., bn].
+// Cada fila de x tiene las n variables de una observacion
+func LinearRegression(x [][]float64, y []float64) ([]float64, error) {
+	if len(x) == 0 || len(x) != len(y) {
+		return nil, ErrSingular
+	}
+
+	size := len(x[0]) + 1
+	if len(x) < size {
+		return nil, ErrSingular
+	}
+
+	// Normal equations (XᵗX)b = Xᵗy with a column of ones for the intercept
+	matrix := make([][]float64, size)
+	for i := range matrix {
+		matrix[i] = make([]float64, size+1)
+	}
+
+	for row := range x {
+		features := append([]float64{1}, x[row]...)
+		for i := 0; i < size; i++ {
+			for j := 0; j < size; j++ {
+				matrix[i][j] += features[i] * features[j]
+			}
+			matrix[i][size] += features[i] * y[row]
+		}
+	}
+
+	return solve(matrix)
+}
+
+// solve resuelve el sistema aumentado con eliminacion de Gauss y pivoteo parcial
+func solve(matrix [][]float64) ([]float64, error) {
+	size := len(matrix)
+
+	for col := 0; col < size; col++ {
+		pivot := col
+		for row := col + 1; row < size; row++ {
+			if math.Abs(matrix[row][col]) > math.Abs(matrix[pivot][col]) {
+				pivot = row
+			}
+		}
+
+		if math.Abs(matrix[pivot][col]) < 1e-9 {
+			return nil, ErrSingular
+		}
+		matrix[col], matrix[pivot] = matrix[pivot], matrix[col]
+
+		for row := col + 1; row < size; row++ {
+			factor := matrix[row][col] / matrix[col][col]
+			for k := col; k <= size; k++ {
+				matrix[row][k] -= factor * matrix[col][k]
+			}
+		}
+	}
+
+	coefficients := make([]float64, size)
+	for row := size - 1; row >= 0; row-- {
+		value := matrix[row][size]
+		for k := row + 1; k < size; k++ {
+			value -= matrix[row][k] * coefficients[k]
+		}
+		coefficients[row] = value / matrix[row][row]
+	}
+
+	return coefficients, nil
+}
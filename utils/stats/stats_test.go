@@ -0,0 +1,123 @@
+package stats
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestQuantile(t *testing.T) {
+	tests := []struct {
+		name   string
+		sorted []float64
+		q      float64
+		want   float64
+	}{
+		{"empty", nil, 0.5, 0},
+		{"single", []float64{7}, 0.75, 7},
+		{"median odd", []float64{1, 2, 3}, 0.5, 2},
+		{"median even", []float64{1, 2, 3, 4}, 0.5, 2.5},
+		{"first quartile interpolated", []float64{10, 20, 30, 40, 50, 60}, 0.25, 22.5},
+		{"min", []float64{10, 20, 30}, 0, 10},
+		{"max", []float64{10, 20, 30}, 1, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Quantile(tt.sorted, tt.q); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Quantile(%v, %v) = %v, want %v", tt.sorted, tt.q, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	values := []float64{40, 10, 30, 20}
+	got := Summarize(values)
+	want := Summary{Count: 4, Mean: 25, Median: 25, Q1: 17.5, Q3: 32.5}
+
+	if got != want {
+		t.Errorf("Summarize(%v) = %+v, want %+v", values, got, want)
+	}
+	if values[0] != 40 {
+		t.Errorf("Summarize modified the values: %v", values)
+	}
+	if got.IQR() != 15 {
+		t.Errorf("IQR() = %v, want 15", got.IQR())
+	}
+}
+
+func TestLinearRegression(t *testing.T) {
+	tests := []struct {
+		name    string
+		x       [][]float64
+		y       []float64
+		want    []float64
+		wantErr error
+	}{
+		{
+			name: "line",
+			x:    [][]float64{{0}, {1}, {2}, {3}},
+			y:    []float64{1, 3, 5, 7},
+			want: []float64{1, 2},
+		},
+		{
+			name: "least squares line",
+			x:    [][]float64{{0}, {1}, {2}},
+			y:    []float64{0, 2, 1},
+			want: []float64{0.5, 0.5},
+		},
+		{
+			name: "two variables",
+			x:    [][]float64{{0, 0}, {1, 0}, {0, 1}, {2, 3}, {1, 1}},
+			y:    []float64{10, 12, 7, 5, 9},
+			want: []float64{10, 2, -3},
+		},
+		{
+			name:    "fewer observations than coefficients",
+			x:       [][]float64{{1, 2}, {2, 1}},
+			y:       []float64{1, 2},
+			wantErr: ErrSingular,
+		},
+		{
+			name:    "constant variable",
+			x:       [][]float64{{5}, {5}, {5}},
+			y:       []float64{1, 2, 3},
+			wantErr: ErrSingular,
+		},
+		{
+			name:    "collinear variables",
+			x:       [][]float64{{1, 2}, {2, 4}, {3, 6}, {4, 8}},
+			y:       []float64{1, 2, 3, 4},
+			wantErr: ErrSingular,
+		},
+		{
+			name:    "different lengths",
+			x:       [][]float64{{1}, {2}, {3}},
+			y:       []float64{1, 2},
+			wantErr: ErrSingular,
+		},
+		{
+			name:    "empty",
+			wantErr: ErrSingular,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LinearRegression(tt.x, tt.y)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LinearRegression() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("LinearRegression() = %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if math.Abs(got[i]-tt.want[i]) > 1e-9 {
+					t.Errorf("LinearRegression() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
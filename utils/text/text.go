@@ -0,0 +1,58 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// searchPattern acepta letras de cualquier idioma (con tildes), numeros, espacios, guiones y puntos
+var searchPattern = regexp.MustCompile(`^[\p{L}\p{M}\p{N}\s.\-]+$`)
+
+// accentVariants relaciona cada letra sin tilde con las variantes que debe encontrar en Mongo
+var accentVariants = map[rune]string{
+	'a': "aáàäâã",
+	'e': "eéèëê",
+	'i': "iíìïî",
+	'o': "oóòöôõ",
+	'u': "uúùüû",
+	'n': "nñ",
+	'c': "cç",
+}
+
+// IsValidSearch valida que el texto de busqueda solo tenga letras, numeros, espacios, guiones y puntos
+func IsValidSearch(value string) bool {
+	return searchPattern.MatchString(value)
+}
+
+// Fold normaliza el texto con NFKD, quita las tildes, lo deja en minusculas y con espacios simples,
+// asi "Bogotá  D.C" y "bogota d.c" quedan iguales
+func Fold(value string) string {
+	folder := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(folder, value)
+	if err != nil {
+		folded = value
+	}
+
+	return strings.Join(strings.Fields(strings.ToLower(folded)), " ")
+}
+
+// AccentInsensitivePattern arma una expresion regular escapada que encuentra el texto
+// sin importar tildes, para usar con $regex y la opcion "i"
+func AccentInsensitivePattern(value string) string {
+	var pattern strings.Builder
+
+	for _, char := range Fold(value) {
+		if variants, ok := accentVariants[char]; ok {
+			pattern.WriteString("[" + variants + "]")
+			continue
+		}
+		pattern.WriteString(regexp.QuoteMeta(string(char)))
+	}
+
+	return pattern.String()
+}